@@ -20,6 +20,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/twmb/franz-go/pkg/kgo"
 
+	"github.com/hexolan/stocklet/internal/pkg/fx"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
 	"github.com/hexolan/stocklet/internal/pkg/metrics"
 	"github.com/hexolan/stocklet/internal/pkg/serve"
@@ -43,6 +44,21 @@ func loadConfig() *order.ServiceConfig {
 	return cfg
 }
 
+func useRateProvider(cfg *order.ServiceConfig) fx.RateProvider {
+	// load the FX configuration
+	if err := cfg.FX.Load(); err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	// create the exchange rate provider
+	rates, err := fx.NewRateProvider(&cfg.FX)
+	if err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	return rates
+}
+
 func usePostgresController(cfg *order.ServiceConfig) (order.StorageController, *pgxpool.Pool) {
 	// load the Postgres configuration
 	if err := cfg.Postgres.Load(); err != nil {
@@ -55,7 +71,7 @@ func usePostgresController(cfg *order.ServiceConfig) (order.StorageController, *
 		log.Panic().Err(err).Msg("")
 	}
 
-	controller := controller.NewPostgresController(client)
+	controller := controller.NewPostgresController(client, &cfg.ServiceOpts)
 	return controller, client
 }
 
@@ -83,7 +99,8 @@ func main() {
 	defer storeCl.Close()
 
 	// Create the service (& API interfaces)
-	svc := order.NewOrderService(cfg, store)
+	rates := useRateProvider(cfg)
+	svc := order.NewOrderService(cfg, store, rates)
 	grpcSvr := api.PrepareGrpc(cfg, svc)
 	gatewayMux := api.PrepareGateway(cfg)
 
@@ -0,0 +1,85 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/hexolan/stocklet/internal/pkg/config"
+	"github.com/hexolan/stocklet/internal/pkg/metrics"
+	"github.com/hexolan/stocklet/internal/pkg/storage"
+	"github.com/hexolan/stocklet/internal/svc/payment"
+	"github.com/hexolan/stocklet/internal/svc/payment/controller"
+)
+
+// Reconcile the cached customer balances against the payment ledger.
+//
+// Exits with a non-zero status if any balances have drifted from the ledger
+// (unless they have been repaired).
+func main() {
+	repair := flag.Bool("repair", false, "reset drifted balances to the amounts derived from the ledger")
+	flag.Parse()
+
+	metrics.ConfigureLogger()
+
+	// load the Postgres configuration
+	pgConf := config.PostgresConfig{}
+	if err := pgConf.Load(); err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	// load the service config opts
+	serviceOpts := payment.ServiceConfigOpts{}
+	if err := serviceOpts.Load(); err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	// open a Postgres connection
+	client, err := storage.NewPostgresConn(&pgConf)
+	if err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+	defer client.Close()
+
+	// exchange rates are not required for reconciliation
+	store := controller.NewPostgresController(client, &serviceOpts, nil)
+
+	drifts, err := store.ReconcileBalances(context.Background(), *repair)
+	if err != nil {
+		log.Panic().Err(err).Msg("failed to reconcile balances")
+	}
+
+	for _, drift := range drifts {
+		log.Warn().
+			Str("customer_id", drift.CustomerId).
+			Str("currency", drift.LedgerBalance.GetCurrencyCode()).
+			Int64("cached_balance", drift.CachedBalance.GetMinorUnits()).
+			Int64("ledger_balance", drift.LedgerBalance.GetMinorUnits()).
+			Int64("cached_held", drift.CachedHeld.GetMinorUnits()).
+			Int64("ledger_held", drift.LedgerHeld.GetMinorUnits()).
+			Bool("repaired", *repair).
+			Msg("balance has drifted from the ledger")
+	}
+
+	log.Info().Int("drifted", len(drifts)).Msg("completed balance reconciliation")
+	if len(drifts) > 0 && !*repair {
+		os.Exit(1)
+	}
+}
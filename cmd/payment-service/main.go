@@ -16,12 +16,17 @@
 package main
 
 import (
+	"context"
+	"time"
+
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rs/zerolog/log"
 	"github.com/twmb/franz-go/pkg/kgo"
 
+	"github.com/hexolan/stocklet/internal/pkg/fx"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
 	"github.com/hexolan/stocklet/internal/pkg/metrics"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	"github.com/hexolan/stocklet/internal/pkg/serve"
 	"github.com/hexolan/stocklet/internal/pkg/storage"
 	"github.com/hexolan/stocklet/internal/svc/payment"
@@ -43,7 +48,47 @@ func loadConfig() *payment.ServiceConfig {
 	return cfg
 }
 
-func usePostgresController(cfg *payment.ServiceConfig) (payment.StorageController, *pgxpool.Pool) {
+func useRateProvider(cfg *payment.ServiceConfig) fx.RateProvider {
+	// load the FX configuration
+	if err := cfg.FX.Load(); err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	// create the exchange rate provider
+	rates, err := fx.NewRateProvider(&cfg.FX)
+	if err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	return rates
+}
+
+func useFundingSource(cfg *payment.ServiceConfig) payment.FundingSource {
+	switch cfg.ServiceOpts.FundingSource {
+	case "fakecard":
+		return controller.NewFakeCardProcessor(&cfg.ServiceOpts)
+	default:
+		log.Panic().Str("funding_source", cfg.ServiceOpts.FundingSource).Msg("unsupported funding source")
+	}
+
+	return nil
+}
+
+func useRiskEngine(cfg *payment.ServiceConfig, store payment.StorageController, rates fx.RateProvider) *payment.RiskEngine {
+	// load the risk rules (if configured)
+	rules := []*payment.RiskRule{}
+	if cfg.ServiceOpts.RiskRulesFile != "" {
+		var err error
+		rules, err = payment.LoadRiskRules(cfg.ServiceOpts.RiskRulesFile)
+		if err != nil {
+			log.Panic().Err(err).Msg("")
+		}
+	}
+
+	return payment.NewRiskEngine(rules, store, rates)
+}
+
+func usePostgresController(cfg *payment.ServiceConfig, rates fx.RateProvider) (payment.StorageController, *pgxpool.Pool) {
 	// load the Postgres configuration
 	if err := cfg.Postgres.Load(); err != nil {
 		log.Panic().Err(err).Msg("")
@@ -55,7 +100,7 @@ func usePostgresController(cfg *payment.ServiceConfig) (payment.StorageControlle
 		log.Panic().Err(err).Msg("")
 	}
 
-	controller := controller.NewPostgresController(client)
+	controller := controller.NewPostgresController(client, &cfg.ServiceOpts, rates)
 	return controller, client
 }
 
@@ -79,11 +124,19 @@ func main() {
 	cfg := loadConfig()
 
 	// Create the storage controller
-	store, storeCl := usePostgresController(cfg)
+	rates := useRateProvider(cfg)
+	store, storeCl := usePostgresController(cfg, rates)
 	defer storeCl.Close()
 
 	// Create the service (& API interfaces)
-	svc := payment.NewPaymentService(cfg, store)
+	funding := useFundingSource(cfg)
+	providers := map[commonpb.PaymentMethod]payment.PaymentProvider{
+		commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE:   controller.NewBalancePaymentProvider(store),
+		commonpb.PaymentMethod_PAYMENT_METHOD_CARD:      controller.NewCardPaymentProvider(store, funding),
+		commonpb.PaymentMethod_PAYMENT_METHOD_GIFT_CARD: controller.NewGiftCardPaymentProvider(store),
+	}
+	risk := useRiskEngine(cfg, store, rates)
+	svc := payment.NewPaymentService(cfg, store, funding, providers, risk)
 	grpcSvr := api.PrepareGrpc(cfg, svc)
 	gatewayMux := api.PrepareGateway(cfg)
 
@@ -92,6 +145,15 @@ func main() {
 	defer consCl.Close()
 	consumer.Attach(svc)
 
+	// Periodically void expired payment authorizations
+	go svc.RunAuthorizationExpiry(context.Background(), time.Minute)
+
+	// Periodically expire gift cards
+	go svc.RunGiftCardExpiry(context.Background(), time.Minute)
+
+	// Periodically generate monthly statements
+	go svc.RunStatementGeneration(context.Background(), time.Hour)
+
 	// Serve/start the interfaces
 	go consumer.Start()
 	go serve.Gateway(gatewayMux)
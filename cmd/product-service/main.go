@@ -20,6 +20,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/twmb/franz-go/pkg/kgo"
 
+	"github.com/hexolan/stocklet/internal/pkg/fx"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
 	"github.com/hexolan/stocklet/internal/pkg/metrics"
 	"github.com/hexolan/stocklet/internal/pkg/serve"
@@ -43,7 +44,22 @@ func loadConfig() *product.ServiceConfig {
 	return cfg
 }
 
-func usePostgresController(cfg *product.ServiceConfig) (product.StorageController, *pgxpool.Pool) {
+func useRateProvider(cfg *product.ServiceConfig) fx.RateProvider {
+	// load the FX configuration
+	if err := cfg.FX.Load(); err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	// create the exchange rate provider
+	rates, err := fx.NewRateProvider(&cfg.FX)
+	if err != nil {
+		log.Panic().Err(err).Msg("")
+	}
+
+	return rates
+}
+
+func usePostgresController(cfg *product.ServiceConfig, rates fx.RateProvider) (product.StorageController, *pgxpool.Pool) {
 	// load the Postgres configuration
 	if err := cfg.Postgres.Load(); err != nil {
 		log.Panic().Err(err).Msg("")
@@ -55,7 +71,7 @@ func usePostgresController(cfg *product.ServiceConfig) (product.StorageControlle
 		log.Panic().Err(err).Msg("")
 	}
 
-	controller := controller.NewPostgresController(client)
+	controller := controller.NewPostgresController(client, &cfg.ServiceOpts, rates)
 	return controller, client
 }
 
@@ -79,7 +95,8 @@ func main() {
 	cfg := loadConfig()
 
 	// Create the storage controller
-	store, storeCl := usePostgresController(cfg)
+	rates := useRateProvider(cfg)
+	store, storeCl := usePostgresController(cfg, rates)
 	defer storeCl.Close()
 
 	// Create the service (& API interfaces)
@@ -55,7 +55,7 @@ func usePostgresController(cfg *shipping.ServiceConfig) (shipping.StorageControl
 		log.Panic().Err(err).Msg("")
 	}
 
-	controller := controller.NewPostgresController(client)
+	controller := controller.NewPostgresController(client, &cfg.ServiceOpts)
 	return controller, client
 }
 
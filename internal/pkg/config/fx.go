@@ -0,0 +1,47 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package config
+
+type FXConfig struct {
+	// Env Var: "FX_PROVIDER" (optional)
+	// The source of exchange rates (currently only "file")
+	// Defaults to "file"
+	Provider string
+
+	// Env Var: "FX_RATES_FILE"
+	// Path to the exchange rates file (required by the "file" provider)
+	RatesFile string
+}
+
+func (cfg *FXConfig) Load() error {
+	// load configurations from env
+	if opt, err := RequireFromEnv("FX_PROVIDER"); err != nil {
+		cfg.Provider = "file"
+	} else {
+		cfg.Provider = opt
+	}
+
+	if cfg.Provider == "file" {
+		if opt, err := RequireFromEnv("FX_RATES_FILE"); err != nil {
+			return err
+		} else {
+			cfg.RatesFile = opt
+		}
+	}
+
+	// Config options were successfully loaded
+	return nil
+}
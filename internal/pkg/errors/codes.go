@@ -34,6 +34,7 @@ const (
 	ErrCodeUnauthorised
 
 	ErrCodeInvalidArgument
+	ErrCodeInvalidState
 )
 
 // Maps the custom service error codes
@@ -52,6 +53,7 @@ func (c ErrorCode) GRPCCode() codes.Code {
 		ErrCodeUnauthorised: codes.PermissionDenied,
 
 		ErrCodeInvalidArgument: codes.InvalidArgument,
+		ErrCodeInvalidState:    codes.FailedPrecondition,
 	}
 
 	grpcCode, mapped := codeMap[c]
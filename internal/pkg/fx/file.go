@@ -0,0 +1,85 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+)
+
+// Exchange rates loaded from a JSON file
+//
+// Intended as a stand-in for an external rates source (e.g. for offline use).
+// The file is in the form of:
+//
+//	{"base": "USD", "rates": {"GBP": 0.79, "EUR": 0.92}}
+//
+// Where each rate is the value of 1 unit of the base currency.
+type fileRateProvider struct {
+	rates map[string]float64
+}
+
+type ratesFile struct {
+	Base  string             `json:"base"`
+	Rates map[string]float64 `json:"rates"`
+}
+
+// Load the exchange rates from a file
+func NewFileRateProvider(path string) (RateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to read fx rates file", err)
+	}
+
+	var file ratesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "malformed fx rates file", err)
+	} else if file.Base == "" {
+		return nil, errors.NewServiceError(errors.ErrCodeService, "malformed fx rates file: no base currency")
+	}
+
+	for currency, rate := range file.Rates {
+		if rate <= 0 {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeService, "malformed fx rates file: invalid rate for %s", currency)
+		}
+	}
+
+	// The base currency is always 1 unit of itself
+	if file.Rates == nil {
+		file.Rates = make(map[string]float64)
+	}
+	file.Rates[file.Base] = 1
+
+	return fileRateProvider{rates: file.Rates}, nil
+}
+
+func (p fileRateProvider) GetRate(ctx context.Context, from string, to string) (float64, error) {
+	fromRate, ok := p.rates[from]
+	if !ok {
+		return 0, errors.NewServiceErrorf(errors.ErrCodeNotFound, "no exchange rate available for %s", from)
+	}
+
+	toRate, ok := p.rates[to]
+	if !ok {
+		return 0, errors.NewServiceErrorf(errors.ErrCodeNotFound, "no exchange rate available for %s", to)
+	}
+
+	// Cross the rates through the base currency
+	return toRate / fromRate, nil
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx
+
+import (
+	"context"
+
+	"github.com/hexolan/stocklet/internal/pkg/config"
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+)
+
+// Interface for exchange rate sources
+// Flexibility for implementing separate providers (e.g. a file, an external rates API, etc)
+type RateProvider interface {
+	// Get the rate used to convert an amount from one currency to another
+	GetRate(ctx context.Context, from string, to string) (float64, error)
+}
+
+// Create the exchange rate provider specified in the configuration
+func NewRateProvider(cfg *config.FXConfig) (RateProvider, error) {
+	switch cfg.Provider {
+	case "file":
+		return NewFileRateProvider(cfg.RatesFile)
+	default:
+		return nil, errors.NewServiceErrorf(errors.ErrCodeService, "unknown fx provider (%s)", cfg.Provider)
+	}
+}
+
+// Convert an amount of money into another currency
+//
+// Returns the converted amount alongside the exchange rate used.
+func Convert(ctx context.Context, provider RateProvider, amount *commonpb.Money, currency string) (*commonpb.Money, float64, error) {
+	if amount.GetCurrencyCode() == currency {
+		return money.New(amount.GetMinorUnits(), currency), 1, nil
+	}
+
+	rate, err := provider.GetRate(ctx, amount.GetCurrencyCode(), currency)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return money.New(money.Convert(amount.GetMinorUnits(), amount.GetCurrencyCode(), currency, rate), currency), rate, nil
+}
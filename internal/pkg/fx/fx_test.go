@@ -0,0 +1,127 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package fx
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+)
+
+// A rate provider that fails any lookups
+type unavailableRateProvider struct{}
+
+func (p unavailableRateProvider) GetRate(ctx context.Context, from string, to string) (float64, error) {
+	return 0, errors.NewServiceError(errors.ErrCodeExtService, "rates unavailable")
+}
+
+// Load a rate provider from the contents of a rates file
+func loadRatesFile(t *testing.T, contents string) (RateProvider, error) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "rates.json")
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("failed to write rates file: %v", err)
+	}
+
+	return NewFileRateProvider(path)
+}
+
+func TestConvertWithRatesFile(t *testing.T) {
+	rates, err := loadRatesFile(t, `{"base": "USD", "rates": {"EUR": 0.5, "GBP": 0.8, "JPY": 150, "KWD": 0.3}}`)
+	if err != nil {
+		t.Fatalf("NewFileRateProvider() unexpected error: %v", err)
+	}
+
+	convert := func(t *testing.T, minorUnits int64, from string, to string, wantMinorUnits int64, wantRate float64) {
+		t.Helper()
+
+		got, rate, err := Convert(context.Background(), rates, money.New(minorUnits, from), to)
+		if err != nil {
+			t.Fatalf("Convert() unexpected error: %v", err)
+		}
+
+		if got.GetMinorUnits() != wantMinorUnits || got.GetCurrencyCode() != to {
+			t.Errorf("Convert() = %d %s, want %d %s", got.GetMinorUnits(), got.GetCurrencyCode(), wantMinorUnits, to)
+		}
+
+		if rate != wantRate {
+			t.Errorf("Convert() rate = %v, want %v", rate, wantRate)
+		}
+	}
+
+	t.Run("from the base currency", func(t *testing.T) {
+		convert(t, 1000, "USD", "EUR", 500, 0.5)
+	})
+
+	t.Run("to the base currency", func(t *testing.T) {
+		convert(t, 500, "EUR", "USD", 1000, 2)
+	})
+
+	t.Run("crossed through the base currency", func(t *testing.T) {
+		convert(t, 1000, "EUR", "GBP", 1600, 1.6)
+	})
+
+	t.Run("between currencies with different minor units", func(t *testing.T) {
+		convert(t, 1000, "USD", "JPY", 1500, 150)
+		convert(t, 1500, "JPY", "USD", 1000, 1.0/150)
+		convert(t, 1000, "USD", "KWD", 3000, 0.3)
+	})
+
+	t.Run("rounded half away from zero", func(t *testing.T) {
+		convert(t, 333, "USD", "EUR", 167, 0.5)
+	})
+
+	t.Run("unknown currency", func(t *testing.T) {
+		_, _, err := Convert(context.Background(), rates, money.New(1000, "USD"), "XYZ")
+		if svcErr, ok := err.(*errors.ServiceError); !ok || svcErr.Code() != errors.ErrCodeNotFound {
+			t.Errorf("Convert() error = %v, want a not found error", err)
+		}
+	})
+}
+
+func TestConvertSameCurrency(t *testing.T) {
+	// No rate is required to "convert" into the same currency
+	got, rate, err := Convert(context.Background(), unavailableRateProvider{}, money.New(1234, "USD"), "USD")
+	if err != nil {
+		t.Fatalf("Convert() unexpected error: %v", err)
+	} else if got.GetMinorUnits() != 1234 || got.GetCurrencyCode() != "USD" || rate != 1 {
+		t.Errorf("Convert() = %d %s (rate %v), want 1234 USD (rate 1)", got.GetMinorUnits(), got.GetCurrencyCode(), rate)
+	}
+
+	// Otherwise provider errors are passed on
+	_, _, err = Convert(context.Background(), unavailableRateProvider{}, money.New(1234, "USD"), "EUR")
+	if svcErr, ok := err.(*errors.ServiceError); !ok || svcErr.Code() != errors.ErrCodeExtService {
+		t.Errorf("Convert() error = %v, want the provider error", err)
+	}
+}
+
+func TestNewFileRateProviderMalformed(t *testing.T) {
+	for _, contents := range []string{
+		`not json`,
+		`{"rates": {"EUR": 0.5}}`,
+		`{"base": "USD", "rates": {"EUR": 0}}`,
+		`{"base": "USD", "rates": {"EUR": -0.5}}`,
+	} {
+		if _, err := loadRatesFile(t, contents); err == nil {
+			t.Errorf("NewFileRateProvider() accepted malformed rates file: %s", contents)
+		}
+	}
+}
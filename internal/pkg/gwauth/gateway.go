@@ -25,14 +25,39 @@ import (
 	"github.com/hexolan/stocklet/internal/pkg/errors"
 )
 
-const JWTPayloadHeader string = "X-JWT-Payload"
+const (
+	JWTPayloadHeader     string = "X-JWT-Payload"
+	IdempotencyKeyHeader string = "Idempotency-Key"
+)
+
+// Roles that can be granted in the "role" claim of tokens
+//
+// Tokens without a role claim are issued to customers.
+const (
+	RoleCustomer string = "customer"
+	RoleAdmin    string = "admin"
+	RoleService  string = "service"
+)
 
 type JWTClaims struct {
 	Subject  string `json:"sub"`
+	Role     string `json:"role,omitempty"`
 	IssuedAt int64  `json:"iat"`
 	Expiry   int64  `json:"exp"`
 }
 
+// Check if the token grants elevated (admin or service) access.
+func (claims *JWTClaims) HasElevatedAccess() bool {
+	return claims.Role == RoleAdmin || claims.Role == RoleService
+}
+
+// Check if the token grants access to a customer's resources.
+//
+// Customers can only access their own resources.
+func (claims *JWTClaims) CanAccessCustomer(customerId string) bool {
+	return claims.Subject == customerId || claims.HasElevatedAccess()
+}
+
 func IsGatewayRequest(ctx context.Context) (bool, *metadata.MD) {
 	// Check the gRPC request has metadata
 	md, exists := metadata.FromIncomingContext(ctx)
@@ -71,6 +96,18 @@ func GetGatewayUser(md *metadata.MD) (*JWTClaims, error) {
 	return &claims, nil
 }
 
+// Get the idempotency key provided with a gateway request (if any).
+//
+// The gateway forwards the "Idempotency-Key" header as metadata.
+func GetGatewayIdempotencyKey(md *metadata.MD) *string {
+	keys := md.Get("idempotency-key")
+	if len(keys) != 1 || keys[0] == "" {
+		return nil
+	}
+
+	return &keys[0]
+}
+
 func getTokenPayload(md *metadata.MD) (*JWTClaims, error) {
 	// Envoy validates JWT tokens and provides a header containing
 	// valid auth token claims in base64 format.
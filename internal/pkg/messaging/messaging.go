@@ -28,11 +28,19 @@ type ConsumerController interface {
 // Topic Definitions
 const (
 	// Order Topics
-	Order_State_Topic          = "order.state"
-	Order_State_Created_Topic  = Order_State_Topic + ".created"
-	Order_State_Pending_Topic  = Order_State_Topic + ".pending"
-	Order_State_Rejected_Topic = Order_State_Topic + ".rejected"
-	Order_State_Approved_Topic = Order_State_Topic + ".approved"
+	Order_State_Topic           = "order.state"
+	Order_State_Created_Topic   = Order_State_Topic + ".created"
+	Order_State_Pending_Topic   = Order_State_Topic + ".pending"
+	Order_State_Rejected_Topic  = Order_State_Topic + ".rejected"
+	Order_State_Approved_Topic  = Order_State_Topic + ".approved"
+	Order_State_Completed_Topic = Order_State_Topic + ".completed"
+	Order_State_Cancelled_Topic = Order_State_Topic + ".cancelled"
+
+	Order_Return_Topic           = "order.return"
+	Order_Return_Requested_Topic = Order_Return_Topic + ".requested"
+	Order_Return_Approved_Topic  = Order_Return_Topic + ".approved"
+	Order_Return_Rejected_Topic  = Order_Return_Topic + ".rejected"
+	Order_Return_Completed_Topic = Order_Return_Topic + ".completed"
 
 	// Payment Topics
 	Payment_Balance_Topic          = "payment.balance"
@@ -44,16 +52,37 @@ const (
 	Payment_Transaction_Topic          = "payment.transaction"
 	Payment_Transaction_Created_Topic  = Payment_Transaction_Topic + ".created"
 	Payment_Transaction_Reversed_Topic = Payment_Transaction_Topic + ".reversed"
+	Payment_Transaction_Captured_Topic = Payment_Transaction_Topic + ".captured"
+	Payment_Transaction_Voided_Topic   = Payment_Transaction_Topic + ".voided"
 
-	Payment_Processing_Topic = "payment.processing"
+	Payment_Gift_Card_Topic          = "payment.giftcard"
+	Payment_Gift_Card_Issued_Topic   = Payment_Gift_Card_Topic + ".issued"
+	Payment_Gift_Card_Redeemed_Topic = Payment_Gift_Card_Topic + ".redeemed"
+	Payment_Gift_Card_Expired_Topic  = Payment_Gift_Card_Topic + ".expired"
+
+	Payment_Processing_Topic      = "payment.processing"
+	Payment_Refund_Topic          = "payment.refund"
+	Payment_Unpaid_Shipment_Topic = "payment.unpaidshipment"
 
 	// Product Topics
 	Product_State_Topic         = "product.state"
 	Product_State_Created_Topic = Product_State_Topic + ".created"
 	Product_State_Deleted_Topic = Product_State_Topic + ".deleted"
 
-	Product_Attribute_Topic       = "product.attr"
-	Product_Attribute_Price_Topic = Product_Attribute_Topic + ".price"
+	Product_Attribute_Topic          = "product.attr"
+	Product_Attribute_Price_Topic    = Product_Attribute_Topic + ".price"
+	Product_Attribute_Details_Topic  = Product_Attribute_Topic + ".details"
+	Product_Attribute_Category_Topic = Product_Attribute_Topic + ".category"
+
+	Product_Variant_Topic         = "product.variant"
+	Product_Variant_Created_Topic = Product_Variant_Topic + ".created"
+	Product_Variant_Updated_Topic = Product_Variant_Topic + ".updated"
+	Product_Variant_Deleted_Topic = Product_Variant_Topic + ".deleted"
+
+	Product_Category_Topic         = "product.category"
+	Product_Category_Created_Topic = Product_Category_Topic + ".created"
+	Product_Category_Updated_Topic = Product_Category_Topic + ".updated"
+	Product_Category_Deleted_Topic = Product_Category_Topic + ".deleted"
 
 	Product_PriceQuotation_Topic = "product.pricequotation"
 
@@ -67,8 +96,9 @@ const (
 	User_State_Created_Topic = User_State_Topic + ".created"
 	User_State_Deleted_Topic = User_State_Topic + ".deleted"
 
-	User_Attribute_Topic       = "user.attr"
-	User_Attribute_Email_Topic = User_Attribute_Topic + ".email"
+	User_Attribute_Topic          = "user.attr"
+	User_Attribute_Email_Topic    = User_Attribute_Topic + ".email"
+	User_Attribute_Currency_Topic = User_Attribute_Topic + ".currency"
 
 	// Warehouse Topics
 	Warehouse_Stock_Topic         = "warehouse.stock"
@@ -0,0 +1,111 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+)
+
+// The currency used for amounts where one has not been specified.
+const DefaultCurrency string = "USD"
+
+// ISO 4217 currencies that do not use the default of 2 minor unit digits.
+var currencyExponents = map[string]int{
+	"BHD": 3,
+	"CLP": 0,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"OMR": 3,
+	"TND": 3,
+	"VND": 0,
+}
+
+// Create a money message from an amount in minor units
+func New(minorUnits int64, currencyCode string) *pb.Money {
+	return &pb.Money{MinorUnits: minorUnits, CurrencyCode: currencyCode}
+}
+
+// Get the number of minor unit digits used by a currency
+// (e.g. 2 for GBP, where 1 GBP = 100 pence)
+func Exponent(currencyCode string) int {
+	if exponent, ok := currencyExponents[currencyCode]; ok {
+		return exponent
+	}
+
+	return 2
+}
+
+// Parse a decimal amount (e.g. "10.50") into the minor units of a currency
+func ParseDecimal(value string, currencyCode string) (int64, error) {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, errors.WrapServiceError(errors.ErrCodeInvalidArgument, "invalid amount '"+value+"'", err)
+	}
+
+	return int64(math.Round(amount * math.Pow10(Exponent(currencyCode)))), nil
+}
+
+// Format an amount in the minor units of a currency as a decimal (e.g. "10.50")
+func FormatDecimal(minorUnits int64, currencyCode string) string {
+	sign := ""
+	if minorUnits < 0 {
+		sign = "-"
+		minorUnits = -minorUnits
+	}
+
+	exponent := Exponent(currencyCode)
+	if exponent == 0 {
+		return sign + strconv.FormatInt(minorUnits, 10)
+	}
+
+	divisor := int64(math.Pow10(exponent))
+	return fmt.Sprintf("%s%d.%0*d", sign, minorUnits/divisor, exponent, minorUnits%divisor)
+}
+
+// Multiply an amount by a rate (e.g. a tax or discount rate)
+//
+// The result is rounded half away from zero to the nearest minor unit.
+func MultiplyRate(amount int64, rate float64) int64 {
+	return int64(math.Round(float64(amount) * rate))
+}
+
+// Calculate the proportion (numerator / denominator) of an amount
+//
+// The result is rounded half away from zero to the nearest minor unit.
+func Prorate(amount int64, numerator int64, denominator int64) int64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	return int64(math.Round(float64(amount) * float64(numerator) / float64(denominator)))
+}
+
+// Convert an amount from the minor units of one currency to another
+//
+// The rate is the value of 1 unit of the source currency in the target currency.
+// The result is rounded half away from zero to the nearest minor unit.
+func Convert(amount int64, from string, to string, rate float64) int64 {
+	return int64(math.Round(float64(amount) * rate * math.Pow10(Exponent(to)-Exponent(from))))
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Page layout (A4, in points)
+const (
+	pageWidth  int = 595
+	pageHeight int = 842
+	margin     int = 50
+	fontSize   int = 9
+	leading    int = 12
+
+	linesPerPage int = (pageHeight - (margin * 2)) / leading
+)
+
+// A minimal writer for plain text PDF documents
+//
+// Lines of text are laid out in a monospaced font (Courier) on A4 pages,
+// with new pages started as required. Characters outside of printable
+// ASCII are substituted.
+type Document struct {
+	title string
+	lines []string
+}
+
+func NewDocument(title string) *Document {
+	return &Document{title: title}
+}
+
+// Append a line of text to the document
+func (d *Document) AddLine(line string) {
+	d.lines = append(d.lines, line)
+}
+
+// Render the document
+func (d *Document) Bytes() []byte {
+	// Split the lines into pages
+	pages := [][]string{}
+	for start := 0; start < len(d.lines); start += linesPerPage {
+		end := min(start+linesPerPage, len(d.lines))
+		pages = append(pages, d.lines[start:end])
+	}
+
+	if len(pages) == 0 {
+		pages = append(pages, []string{})
+	}
+
+	// Objects 1-4 are the catalog, page tree, font and document info
+	// followed by a page object and content stream for each page.
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // page tree (populated once the page objects are numbered)
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Title (%s) /Producer (stocklet) >>", escapeText(d.title)),
+	}
+
+	pageRefs := []string{}
+	for _, lines := range pages {
+		pageNum := len(objects) + 1
+		contentNum := pageNum + 1
+		pageRefs = append(pageRefs, fmt.Sprintf("%d 0 R", pageNum))
+
+		objects = append(
+			objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>", pageWidth, pageHeight, contentNum),
+			contentStream(lines),
+		)
+	}
+
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageRefs, " "), len(pageRefs))
+
+	// Write the objects (recording their offsets for the cross-reference table)
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 4 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xrefOffset)
+	return buf.Bytes()
+}
+
+// Build the content stream object for a page of text
+func contentStream(lines []string) string {
+	var content strings.Builder
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", fontSize, leading, margin, pageHeight-margin)
+	for i, line := range lines {
+		if i > 0 {
+			content.WriteString("T*\n")
+		}
+
+		fmt.Fprintf(&content, "(%s) Tj\n", escapeText(line))
+	}
+	content.WriteString("ET")
+
+	return fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String())
+}
+
+// Escape text for use in a PDF string literal
+func escapeText(text string) string {
+	var escaped strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case r < 32 || r > 126:
+			escaped.WriteRune('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: stocklet/common/v1/payment.proto
+
+package common_v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The method used to pay for an order.
+type PaymentMethod int32
+
+const (
+	PaymentMethod_PAYMENT_METHOD_UNSPECIFIED PaymentMethod = 0 // treated as the stored balance
+	PaymentMethod_PAYMENT_METHOD_BALANCE     PaymentMethod = 1 // debited from the customer's stored balance
+	PaymentMethod_PAYMENT_METHOD_CARD        PaymentMethod = 2 // charged to a card (through the card processor)
+	PaymentMethod_PAYMENT_METHOD_GIFT_CARD   PaymentMethod = 3 // paid with a gift card (with any remainder debited from the stored balance)
+)
+
+// Enum value maps for PaymentMethod.
+var (
+	PaymentMethod_name = map[int32]string{
+		0: "PAYMENT_METHOD_UNSPECIFIED",
+		1: "PAYMENT_METHOD_BALANCE",
+		2: "PAYMENT_METHOD_CARD",
+		3: "PAYMENT_METHOD_GIFT_CARD",
+	}
+	PaymentMethod_value = map[string]int32{
+		"PAYMENT_METHOD_UNSPECIFIED": 0,
+		"PAYMENT_METHOD_BALANCE":     1,
+		"PAYMENT_METHOD_CARD":        2,
+		"PAYMENT_METHOD_GIFT_CARD":   3,
+	}
+)
+
+func (x PaymentMethod) Enum() *PaymentMethod {
+	p := new(PaymentMethod)
+	*p = x
+	return p
+}
+
+func (x PaymentMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocklet_common_v1_payment_proto_enumTypes[0].Descriptor()
+}
+
+func (PaymentMethod) Type() protoreflect.EnumType {
+	return &file_stocklet_common_v1_payment_proto_enumTypes[0]
+}
+
+func (x PaymentMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentMethod.Descriptor instead.
+func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
+	return file_stocklet_common_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+// The risk rule that prevented a payment from being taken.
+type PaymentRiskReason int32
+
+const (
+	PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED       PaymentRiskReason = 0
+	PaymentRiskReason_PAYMENT_RISK_REASON_VELOCITY_LIMIT    PaymentRiskReason = 1 // too many recent payments from the customer
+	PaymentRiskReason_PAYMENT_RISK_REASON_MAX_ORDER_VALUE   PaymentRiskReason = 2 // the order value exceeds the maximum allowed
+	PaymentRiskReason_PAYMENT_RISK_REASON_NEW_ACCOUNT_LIMIT PaymentRiskReason = 3 // the order value exceeds the limit for new accounts
+)
+
+// Enum value maps for PaymentRiskReason.
+var (
+	PaymentRiskReason_name = map[int32]string{
+		0: "PAYMENT_RISK_REASON_UNSPECIFIED",
+		1: "PAYMENT_RISK_REASON_VELOCITY_LIMIT",
+		2: "PAYMENT_RISK_REASON_MAX_ORDER_VALUE",
+		3: "PAYMENT_RISK_REASON_NEW_ACCOUNT_LIMIT",
+	}
+	PaymentRiskReason_value = map[string]int32{
+		"PAYMENT_RISK_REASON_UNSPECIFIED":       0,
+		"PAYMENT_RISK_REASON_VELOCITY_LIMIT":    1,
+		"PAYMENT_RISK_REASON_MAX_ORDER_VALUE":   2,
+		"PAYMENT_RISK_REASON_NEW_ACCOUNT_LIMIT": 3,
+	}
+)
+
+func (x PaymentRiskReason) Enum() *PaymentRiskReason {
+	p := new(PaymentRiskReason)
+	*p = x
+	return p
+}
+
+func (x PaymentRiskReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentRiskReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocklet_common_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentRiskReason) Type() protoreflect.EnumType {
+	return &file_stocklet_common_v1_payment_proto_enumTypes[1]
+}
+
+func (x PaymentRiskReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentRiskReason.Descriptor instead.
+func (PaymentRiskReason) EnumDescriptor() ([]byte, []int) {
+	return file_stocklet_common_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+var File_stocklet_common_v1_payment_proto protoreflect.FileDescriptor
+
+var file_stocklet_common_v1_payment_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2a, 0x82, 0x01, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e,
+	0x43, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a,
+	0x18, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
+	0x47, 0x49, 0x46, 0x54, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x03, 0x2a, 0xb4, 0x01, 0x0a, 0x11,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x49, 0x53,
+	0x4b, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x56, 0x45,
+	0x4c, 0x4f, 0x43, 0x49, 0x54, 0x59, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x27,
+	0x0a, 0x23, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x10, 0x02, 0x12, 0x29, 0x0a, 0x25, 0x50, 0x41, 0x59, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x49, 0x53, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e,
+	0x45, 0x57, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x10, 0x03, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x68, 0x65, 0x78, 0x6f, 0x6c, 0x61, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65,
+	0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76,
+	0x31, 0x3b, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_stocklet_common_v1_payment_proto_rawDescOnce sync.Once
+	file_stocklet_common_v1_payment_proto_rawDescData = file_stocklet_common_v1_payment_proto_rawDesc
+)
+
+func file_stocklet_common_v1_payment_proto_rawDescGZIP() []byte {
+	file_stocklet_common_v1_payment_proto_rawDescOnce.Do(func() {
+		file_stocklet_common_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(file_stocklet_common_v1_payment_proto_rawDescData)
+	})
+	return file_stocklet_common_v1_payment_proto_rawDescData
+}
+
+var file_stocklet_common_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_stocklet_common_v1_payment_proto_goTypes = []interface{}{
+	(PaymentMethod)(0),     // 0: stocklet.common.v1.PaymentMethod
+	(PaymentRiskReason)(0), // 1: stocklet.common.v1.PaymentRiskReason
+}
+var file_stocklet_common_v1_payment_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_stocklet_common_v1_payment_proto_init() }
+func file_stocklet_common_v1_payment_proto_init() {
+	if File_stocklet_common_v1_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_stocklet_common_v1_payment_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_stocklet_common_v1_payment_proto_goTypes,
+		DependencyIndexes: file_stocklet_common_v1_payment_proto_depIdxs,
+		EnumInfos:         file_stocklet_common_v1_payment_proto_enumTypes,
+	}.Build()
+	File_stocklet_common_v1_payment_proto = out.File
+	file_stocklet_common_v1_payment_proto_rawDesc = nil
+	file_stocklet_common_v1_payment_proto_goTypes = nil
+	file_stocklet_common_v1_payment_proto_depIdxs = nil
+}
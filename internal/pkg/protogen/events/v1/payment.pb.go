@@ -22,6 +22,7 @@
 package events_v1
 
 import (
+	v1 "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -35,6 +36,171 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BalanceCreditedEvent_Source int32
+
+const (
+	BalanceCreditedEvent_SOURCE_UNSPECIFIED   BalanceCreditedEvent_Source = 0
+	BalanceCreditedEvent_SOURCE_TOP_UP        BalanceCreditedEvent_Source = 1
+	BalanceCreditedEvent_SOURCE_ADJUSTMENT    BalanceCreditedEvent_Source = 2
+	BalanceCreditedEvent_SOURCE_REFUND        BalanceCreditedEvent_Source = 3
+	BalanceCreditedEvent_SOURCE_RELEASED_HOLD BalanceCreditedEvent_Source = 4
+	BalanceCreditedEvent_SOURCE_GIFT_CARD     BalanceCreditedEvent_Source = 5
+)
+
+// Enum value maps for BalanceCreditedEvent_Source.
+var (
+	BalanceCreditedEvent_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "SOURCE_TOP_UP",
+		2: "SOURCE_ADJUSTMENT",
+		3: "SOURCE_REFUND",
+		4: "SOURCE_RELEASED_HOLD",
+		5: "SOURCE_GIFT_CARD",
+	}
+	BalanceCreditedEvent_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED":   0,
+		"SOURCE_TOP_UP":        1,
+		"SOURCE_ADJUSTMENT":    2,
+		"SOURCE_REFUND":        3,
+		"SOURCE_RELEASED_HOLD": 4,
+		"SOURCE_GIFT_CARD":     5,
+	}
+)
+
+func (x BalanceCreditedEvent_Source) Enum() *BalanceCreditedEvent_Source {
+	p := new(BalanceCreditedEvent_Source)
+	*p = x
+	return p
+}
+
+func (x BalanceCreditedEvent_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BalanceCreditedEvent_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocklet_events_v1_payment_proto_enumTypes[0].Descriptor()
+}
+
+func (BalanceCreditedEvent_Source) Type() protoreflect.EnumType {
+	return &file_stocklet_events_v1_payment_proto_enumTypes[0]
+}
+
+func (x BalanceCreditedEvent_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BalanceCreditedEvent_Source.Descriptor instead.
+func (BalanceCreditedEvent_Source) EnumDescriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type BalanceCreditedEvent_AdjustmentReason int32
+
+const (
+	BalanceCreditedEvent_ADJUSTMENT_REASON_UNSPECIFIED  BalanceCreditedEvent_AdjustmentReason = 0
+	BalanceCreditedEvent_ADJUSTMENT_REASON_GOODWILL     BalanceCreditedEvent_AdjustmentReason = 1
+	BalanceCreditedEvent_ADJUSTMENT_REASON_CORRECTION   BalanceCreditedEvent_AdjustmentReason = 2
+	BalanceCreditedEvent_ADJUSTMENT_REASON_PROMOTION    BalanceCreditedEvent_AdjustmentReason = 3
+	BalanceCreditedEvent_ADJUSTMENT_REASON_COMPENSATION BalanceCreditedEvent_AdjustmentReason = 4
+)
+
+// Enum value maps for BalanceCreditedEvent_AdjustmentReason.
+var (
+	BalanceCreditedEvent_AdjustmentReason_name = map[int32]string{
+		0: "ADJUSTMENT_REASON_UNSPECIFIED",
+		1: "ADJUSTMENT_REASON_GOODWILL",
+		2: "ADJUSTMENT_REASON_CORRECTION",
+		3: "ADJUSTMENT_REASON_PROMOTION",
+		4: "ADJUSTMENT_REASON_COMPENSATION",
+	}
+	BalanceCreditedEvent_AdjustmentReason_value = map[string]int32{
+		"ADJUSTMENT_REASON_UNSPECIFIED":  0,
+		"ADJUSTMENT_REASON_GOODWILL":     1,
+		"ADJUSTMENT_REASON_CORRECTION":   2,
+		"ADJUSTMENT_REASON_PROMOTION":    3,
+		"ADJUSTMENT_REASON_COMPENSATION": 4,
+	}
+)
+
+func (x BalanceCreditedEvent_AdjustmentReason) Enum() *BalanceCreditedEvent_AdjustmentReason {
+	p := new(BalanceCreditedEvent_AdjustmentReason)
+	*p = x
+	return p
+}
+
+func (x BalanceCreditedEvent_AdjustmentReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BalanceCreditedEvent_AdjustmentReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocklet_events_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (BalanceCreditedEvent_AdjustmentReason) Type() protoreflect.EnumType {
+	return &file_stocklet_events_v1_payment_proto_enumTypes[1]
+}
+
+func (x BalanceCreditedEvent_AdjustmentReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BalanceCreditedEvent_AdjustmentReason.Descriptor instead.
+func (BalanceCreditedEvent_AdjustmentReason) EnumDescriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{1, 1}
+}
+
+type TransactionVoidedEvent_Reason int32
+
+const (
+	TransactionVoidedEvent_REASON_UNSPECIFIED     TransactionVoidedEvent_Reason = 0
+	TransactionVoidedEvent_REASON_ORDER_REJECTED  TransactionVoidedEvent_Reason = 1
+	TransactionVoidedEvent_REASON_ORDER_CANCELLED TransactionVoidedEvent_Reason = 2
+	TransactionVoidedEvent_REASON_EXPIRED         TransactionVoidedEvent_Reason = 3
+)
+
+// Enum value maps for TransactionVoidedEvent_Reason.
+var (
+	TransactionVoidedEvent_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_ORDER_REJECTED",
+		2: "REASON_ORDER_CANCELLED",
+		3: "REASON_EXPIRED",
+	}
+	TransactionVoidedEvent_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":     0,
+		"REASON_ORDER_REJECTED":  1,
+		"REASON_ORDER_CANCELLED": 2,
+		"REASON_EXPIRED":         3,
+	}
+)
+
+func (x TransactionVoidedEvent_Reason) Enum() *TransactionVoidedEvent_Reason {
+	p := new(TransactionVoidedEvent_Reason)
+	*p = x
+	return p
+}
+
+func (x TransactionVoidedEvent_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransactionVoidedEvent_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocklet_events_v1_payment_proto_enumTypes[2].Descriptor()
+}
+
+func (TransactionVoidedEvent_Reason) Type() protoreflect.EnumType {
+	return &file_stocklet_events_v1_payment_proto_enumTypes[2]
+}
+
+func (x TransactionVoidedEvent_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransactionVoidedEvent_Reason.Descriptor instead.
+func (TransactionVoidedEvent_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{7, 0}
+}
+
 type PaymentProcessedEvent_Type int32
 
 const (
@@ -68,11 +234,11 @@ func (x PaymentProcessedEvent_Type) String() string {
 }
 
 func (PaymentProcessedEvent_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_stocklet_events_v1_payment_proto_enumTypes[0].Descriptor()
+	return file_stocklet_events_v1_payment_proto_enumTypes[3].Descriptor()
 }
 
 func (PaymentProcessedEvent_Type) Type() protoreflect.EnumType {
-	return &file_stocklet_events_v1_payment_proto_enumTypes[0]
+	return &file_stocklet_events_v1_payment_proto_enumTypes[3]
 }
 
 func (x PaymentProcessedEvent_Type) Number() protoreflect.EnumNumber {
@@ -81,7 +247,56 @@ func (x PaymentProcessedEvent_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PaymentProcessedEvent_Type.Descriptor instead.
 func (PaymentProcessedEvent_Type) EnumDescriptor() ([]byte, []int) {
-	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{6, 0}
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type RefundProcessedEvent_Type int32
+
+const (
+	RefundProcessedEvent_TYPE_UNSPECIFIED RefundProcessedEvent_Type = 0
+	RefundProcessedEvent_TYPE_FAILED      RefundProcessedEvent_Type = 1
+	RefundProcessedEvent_TYPE_SUCCESS     RefundProcessedEvent_Type = 2
+)
+
+// Enum value maps for RefundProcessedEvent_Type.
+var (
+	RefundProcessedEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_FAILED",
+		2: "TYPE_SUCCESS",
+	}
+	RefundProcessedEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_FAILED":      1,
+		"TYPE_SUCCESS":     2,
+	}
+)
+
+func (x RefundProcessedEvent_Type) Enum() *RefundProcessedEvent_Type {
+	p := new(RefundProcessedEvent_Type)
+	*p = x
+	return p
+}
+
+func (x RefundProcessedEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundProcessedEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_stocklet_events_v1_payment_proto_enumTypes[4].Descriptor()
+}
+
+func (RefundProcessedEvent_Type) Type() protoreflect.EnumType {
+	return &file_stocklet_events_v1_payment_proto_enumTypes[4]
+}
+
+func (x RefundProcessedEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundProcessedEvent_Type.Descriptor instead.
+func (RefundProcessedEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type BalanceCreatedEvent struct {
@@ -89,9 +304,9 @@ type BalanceCreatedEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision   int32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	CustomerId string  `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Balance    float32 `protobuf:"fixed32,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Revision   int32     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CustomerId string    `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Balance    *v1.Money `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
 func (x *BalanceCreatedEvent) Reset() {
@@ -140,11 +355,11 @@ func (x *BalanceCreatedEvent) GetCustomerId() string {
 	return ""
 }
 
-func (x *BalanceCreatedEvent) GetBalance() float32 {
+func (x *BalanceCreatedEvent) GetBalance() *v1.Money {
 	if x != nil {
 		return x.Balance
 	}
-	return 0
+	return nil
 }
 
 type BalanceCreditedEvent struct {
@@ -152,10 +367,16 @@ type BalanceCreditedEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision   int32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	CustomerId string  `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Amount     float32 `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	NewBalance float32 `protobuf:"fixed32,4,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Revision   int32                       `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CustomerId string                      `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Amount     *v1.Money                   `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	NewBalance *v1.Money                   `protobuf:"bytes,4,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Source     BalanceCreditedEvent_Source `protobuf:"varint,5,opt,name=source,proto3,enum=stocklet.events.v1.BalanceCreditedEvent_Source" json:"source,omitempty"`
+	// Optional - Reference for the credit (e.g. the funding source charge reference, refunded transaction id or gift card id)
+	Reference *string `protobuf:"bytes,6,opt,name=reference,proto3,oneof" json:"reference,omitempty"`
+	// Only set for adjustments
+	AdjustmentReason BalanceCreditedEvent_AdjustmentReason `protobuf:"varint,7,opt,name=adjustment_reason,json=adjustmentReason,proto3,enum=stocklet.events.v1.BalanceCreditedEvent_AdjustmentReason" json:"adjustment_reason,omitempty"`
+	AdjustmentNote   *string                               `protobuf:"bytes,8,opt,name=adjustment_note,json=adjustmentNote,proto3,oneof" json:"adjustment_note,omitempty"`
 }
 
 func (x *BalanceCreditedEvent) Reset() {
@@ -204,18 +425,46 @@ func (x *BalanceCreditedEvent) GetCustomerId() string {
 	return ""
 }
 
-func (x *BalanceCreditedEvent) GetAmount() float32 {
+func (x *BalanceCreditedEvent) GetAmount() *v1.Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *BalanceCreditedEvent) GetNewBalance() float32 {
+func (x *BalanceCreditedEvent) GetNewBalance() *v1.Money {
 	if x != nil {
 		return x.NewBalance
 	}
-	return 0
+	return nil
+}
+
+func (x *BalanceCreditedEvent) GetSource() BalanceCreditedEvent_Source {
+	if x != nil {
+		return x.Source
+	}
+	return BalanceCreditedEvent_SOURCE_UNSPECIFIED
+}
+
+func (x *BalanceCreditedEvent) GetReference() string {
+	if x != nil && x.Reference != nil {
+		return *x.Reference
+	}
+	return ""
+}
+
+func (x *BalanceCreditedEvent) GetAdjustmentReason() BalanceCreditedEvent_AdjustmentReason {
+	if x != nil {
+		return x.AdjustmentReason
+	}
+	return BalanceCreditedEvent_ADJUSTMENT_REASON_UNSPECIFIED
+}
+
+func (x *BalanceCreditedEvent) GetAdjustmentNote() string {
+	if x != nil && x.AdjustmentNote != nil {
+		return *x.AdjustmentNote
+	}
+	return ""
 }
 
 type BalanceDebitedEvent struct {
@@ -223,10 +472,10 @@ type BalanceDebitedEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision   int32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	CustomerId string  `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Amount     float32 `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	NewBalance float32 `protobuf:"fixed32,4,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	Revision   int32     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CustomerId string    `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Amount     *v1.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	NewBalance *v1.Money `protobuf:"bytes,4,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
 }
 
 func (x *BalanceDebitedEvent) Reset() {
@@ -275,18 +524,18 @@ func (x *BalanceDebitedEvent) GetCustomerId() string {
 	return ""
 }
 
-func (x *BalanceDebitedEvent) GetAmount() float32 {
+func (x *BalanceDebitedEvent) GetAmount() *v1.Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *BalanceDebitedEvent) GetNewBalance() float32 {
+func (x *BalanceDebitedEvent) GetNewBalance() *v1.Money {
 	if x != nil {
 		return x.NewBalance
 	}
-	return 0
+	return nil
 }
 
 type BalanceClosedEvent struct {
@@ -294,9 +543,9 @@ type BalanceClosedEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision   int32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	CustomerId string  `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Balance    float32 `protobuf:"fixed32,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Revision   int32     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	CustomerId string    `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Balance    *v1.Money `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
 }
 
 func (x *BalanceClosedEvent) Reset() {
@@ -345,11 +594,11 @@ func (x *BalanceClosedEvent) GetCustomerId() string {
 	return ""
 }
 
-func (x *BalanceClosedEvent) GetBalance() float32 {
+func (x *BalanceClosedEvent) GetBalance() *v1.Money {
 	if x != nil {
 		return x.Balance
 	}
-	return 0
+	return nil
 }
 
 type TransactionLoggedEvent struct {
@@ -357,11 +606,11 @@ type TransactionLoggedEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision      int32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	TransactionId string  `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Amount        float32 `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	OrderId       string  `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId    string  `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Revision      int32     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	TransactionId string    `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *v1.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrderId       string    `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    string    `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 }
 
 func (x *TransactionLoggedEvent) Reset() {
@@ -410,11 +659,11 @@ func (x *TransactionLoggedEvent) GetTransactionId() string {
 	return ""
 }
 
-func (x *TransactionLoggedEvent) GetAmount() float32 {
+func (x *TransactionLoggedEvent) GetAmount() *v1.Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *TransactionLoggedEvent) GetOrderId() string {
@@ -436,11 +685,16 @@ type TransactionReversedEvent struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision      int32   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	TransactionId string  `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Amount        float32 `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	OrderId       string  `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId    string  `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Revision      int32  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	TransactionId string `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// The amount refunded by this reversal
+	Amount     *v1.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrderId    string    `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string    `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// The total amount refunded from the transaction (including previous reversals)
+	RefundedAmount *v1.Money `protobuf:"bytes,6,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	// Whether the transaction has been refunded in full
+	FullyReversed bool `protobuf:"varint,7,opt,name=fully_reversed,json=fullyReversed,proto3" json:"fully_reversed,omitempty"`
 }
 
 func (x *TransactionReversedEvent) Reset() {
@@ -489,11 +743,11 @@ func (x *TransactionReversedEvent) GetTransactionId() string {
 	return ""
 }
 
-func (x *TransactionReversedEvent) GetAmount() float32 {
+func (x *TransactionReversedEvent) GetAmount() *v1.Money {
 	if x != nil {
 		return x.Amount
 	}
-	return 0
+	return nil
 }
 
 func (x *TransactionReversedEvent) GetOrderId() string {
@@ -510,21 +764,34 @@ func (x *TransactionReversedEvent) GetCustomerId() string {
 	return ""
 }
 
-type PaymentProcessedEvent struct {
+func (x *TransactionReversedEvent) GetRefundedAmount() *v1.Money {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return nil
+}
+
+func (x *TransactionReversedEvent) GetFullyReversed() bool {
+	if x != nil {
+		return x.FullyReversed
+	}
+	return false
+}
+
+type TransactionCapturedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Revision      int32                      `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
-	Type          PaymentProcessedEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=stocklet.events.v1.PaymentProcessedEvent_Type" json:"type,omitempty"`
-	OrderId       string                     `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	CustomerId    string                     `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	Amount        float32                    `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	TransactionId *string                    `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	Revision      int32     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	TransactionId string    `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *v1.Money `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrderId       string    `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    string    `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
 }
 
-func (x *PaymentProcessedEvent) Reset() {
-	*x = PaymentProcessedEvent{}
+func (x *TransactionCapturedEvent) Reset() {
+	*x = TransactionCapturedEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_stocklet_events_v1_payment_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,13 +799,13 @@ func (x *PaymentProcessedEvent) Reset() {
 	}
 }
 
-func (x *PaymentProcessedEvent) String() string {
+func (x *TransactionCapturedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentProcessedEvent) ProtoMessage() {}
+func (*TransactionCapturedEvent) ProtoMessage() {}
 
-func (x *PaymentProcessedEvent) ProtoReflect() protoreflect.Message {
+func (x *TransactionCapturedEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_stocklet_events_v1_payment_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,181 +817,1010 @@ func (x *PaymentProcessedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentProcessedEvent.ProtoReflect.Descriptor instead.
-func (*PaymentProcessedEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionCapturedEvent.ProtoReflect.Descriptor instead.
+func (*TransactionCapturedEvent) Descriptor() ([]byte, []int) {
 	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PaymentProcessedEvent) GetRevision() int32 {
+func (x *TransactionCapturedEvent) GetRevision() int32 {
 	if x != nil {
 		return x.Revision
 	}
 	return 0
 }
 
-func (x *PaymentProcessedEvent) GetType() PaymentProcessedEvent_Type {
+func (x *TransactionCapturedEvent) GetTransactionId() string {
 	if x != nil {
-		return x.Type
+		return x.TransactionId
 	}
-	return PaymentProcessedEvent_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *PaymentProcessedEvent) GetOrderId() string {
+func (x *TransactionCapturedEvent) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *TransactionCapturedEvent) GetOrderId() string {
 	if x != nil {
 		return x.OrderId
 	}
 	return ""
 }
 
-func (x *PaymentProcessedEvent) GetCustomerId() string {
+func (x *TransactionCapturedEvent) GetCustomerId() string {
 	if x != nil {
 		return x.CustomerId
 	}
 	return ""
 }
 
-func (x *PaymentProcessedEvent) GetAmount() float32 {
+type TransactionVoidedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision      int32                         `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	TransactionId string                        `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Amount        *v1.Money                     `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	OrderId       string                        `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId    string                        `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Reason        TransactionVoidedEvent_Reason `protobuf:"varint,6,opt,name=reason,proto3,enum=stocklet.events.v1.TransactionVoidedEvent_Reason" json:"reason,omitempty"`
+}
+
+func (x *TransactionVoidedEvent) Reset() {
+	*x = TransactionVoidedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionVoidedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionVoidedEvent) ProtoMessage() {}
+
+func (x *TransactionVoidedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionVoidedEvent.ProtoReflect.Descriptor instead.
+func (*TransactionVoidedEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TransactionVoidedEvent) GetRevision() int32 {
 	if x != nil {
-		return x.Amount
+		return x.Revision
 	}
 	return 0
 }
 
-func (x *PaymentProcessedEvent) GetTransactionId() string {
-	if x != nil && x.TransactionId != nil {
-		return *x.TransactionId
+func (x *TransactionVoidedEvent) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
 	}
 	return ""
 }
 
-var File_stocklet_events_v1_payment_proto protoreflect.FileDescriptor
-
-var file_stocklet_events_v1_payment_proto_rawDesc = []byte{
-	0x0a, 0x20, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x12, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x22, 0x6c, 0x0a, 0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
-	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44,
-	0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x22, 0x6b, 0x0a, 0x12, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xaf,
-	0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f,
-	0x67, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xb1, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xcb, 0x02, 0x0a, 0x15, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
-	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b,
-	0x6c, 0x65, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19,
-	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x88, 0x01, 0x01, 0x22, 0x3f,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
-	0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x68, 0x65, 0x78, 0x6f, 0x6c, 0x61, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65,
-	0x74, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76,
-	0x31, 0x3b, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *TransactionVoidedEvent) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
 }
 
-var (
-	file_stocklet_events_v1_payment_proto_rawDescOnce sync.Once
-	file_stocklet_events_v1_payment_proto_rawDescData = file_stocklet_events_v1_payment_proto_rawDesc
-)
+func (x *TransactionVoidedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
 
-func file_stocklet_events_v1_payment_proto_rawDescGZIP() []byte {
-	file_stocklet_events_v1_payment_proto_rawDescOnce.Do(func() {
-		file_stocklet_events_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(file_stocklet_events_v1_payment_proto_rawDescData)
-	})
-	return file_stocklet_events_v1_payment_proto_rawDescData
+func (x *TransactionVoidedEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
 }
 
-var file_stocklet_events_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_stocklet_events_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_stocklet_events_v1_payment_proto_goTypes = []interface{}{
-	(PaymentProcessedEvent_Type)(0),  // 0: stocklet.events.v1.PaymentProcessedEvent.Type
-	(*BalanceCreatedEvent)(nil),      // 1: stocklet.events.v1.BalanceCreatedEvent
-	(*BalanceCreditedEvent)(nil),     // 2: stocklet.events.v1.BalanceCreditedEvent
-	(*BalanceDebitedEvent)(nil),      // 3: stocklet.events.v1.BalanceDebitedEvent
-	(*BalanceClosedEvent)(nil),       // 4: stocklet.events.v1.BalanceClosedEvent
-	(*TransactionLoggedEvent)(nil),   // 5: stocklet.events.v1.TransactionLoggedEvent
-	(*TransactionReversedEvent)(nil), // 6: stocklet.events.v1.TransactionReversedEvent
-	(*PaymentProcessedEvent)(nil),    // 7: stocklet.events.v1.PaymentProcessedEvent
+func (x *TransactionVoidedEvent) GetReason() TransactionVoidedEvent_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return TransactionVoidedEvent_REASON_UNSPECIFIED
 }
-var file_stocklet_events_v1_payment_proto_depIdxs = []int32{
-	0, // 0: stocklet.events.v1.PaymentProcessedEvent.type:type_name -> stocklet.events.v1.PaymentProcessedEvent.Type
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+
+// Dispatched when a shipment has been dispatched without payment held (or captured) for the order.
+//
+// The payment for the order must be recovered manually.
+type UnpaidShipmentEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int32  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	OrderId    string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ShipmentId string `protobuf:"bytes,3,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
 }
 
-func init() { file_stocklet_events_v1_payment_proto_init() }
-func file_stocklet_events_v1_payment_proto_init() {
-	if File_stocklet_events_v1_payment_proto != nil {
-		return
+func (x *UnpaidShipmentEvent) Reset() {
+	*x = UnpaidShipmentEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
+}
+
+func (x *UnpaidShipmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnpaidShipmentEvent) ProtoMessage() {}
+
+func (x *UnpaidShipmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnpaidShipmentEvent.ProtoReflect.Descriptor instead.
+func (*UnpaidShipmentEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnpaidShipmentEvent) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *UnpaidShipmentEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *UnpaidShipmentEvent) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+type GiftCardIssuedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int32     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	GiftCardId string    `protobuf:"bytes,2,opt,name=gift_card_id,json=giftCardId,proto3" json:"gift_card_id,omitempty"`
+	Value      *v1.Money `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	ExpiresAt  int64     `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *GiftCardIssuedEvent) Reset() {
+	*x = GiftCardIssuedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardIssuedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardIssuedEvent) ProtoMessage() {}
+
+func (x *GiftCardIssuedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardIssuedEvent.ProtoReflect.Descriptor instead.
+func (*GiftCardIssuedEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GiftCardIssuedEvent) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GiftCardIssuedEvent) GetGiftCardId() string {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return ""
+}
+
+func (x *GiftCardIssuedEvent) GetValue() *v1.Money {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GiftCardIssuedEvent) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GiftCardRedeemedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int32  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	GiftCardId string `protobuf:"bytes,2,opt,name=gift_card_id,json=giftCardId,proto3" json:"gift_card_id,omitempty"`
+	CustomerId string `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// The amount redeemed into the customer's balance
+	Amount         *v1.Money `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	RemainingValue *v1.Money `protobuf:"bytes,5,opt,name=remaining_value,json=remainingValue,proto3" json:"remaining_value,omitempty"`
+	// Optional - Set if redeemed to pay for an order.
+	OrderId *string `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3,oneof" json:"order_id,omitempty"`
+}
+
+func (x *GiftCardRedeemedEvent) Reset() {
+	*x = GiftCardRedeemedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardRedeemedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardRedeemedEvent) ProtoMessage() {}
+
+func (x *GiftCardRedeemedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardRedeemedEvent.ProtoReflect.Descriptor instead.
+func (*GiftCardRedeemedEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GiftCardRedeemedEvent) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GiftCardRedeemedEvent) GetGiftCardId() string {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return ""
+}
+
+func (x *GiftCardRedeemedEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GiftCardRedeemedEvent) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *GiftCardRedeemedEvent) GetRemainingValue() *v1.Money {
+	if x != nil {
+		return x.RemainingValue
+	}
+	return nil
+}
+
+func (x *GiftCardRedeemedEvent) GetOrderId() string {
+	if x != nil && x.OrderId != nil {
+		return *x.OrderId
+	}
+	return ""
+}
+
+type GiftCardExpiredEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int32  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	GiftCardId string `protobuf:"bytes,2,opt,name=gift_card_id,json=giftCardId,proto3" json:"gift_card_id,omitempty"`
+	// The value remaining on the gift card when it expired
+	ForfeitedValue *v1.Money `protobuf:"bytes,3,opt,name=forfeited_value,json=forfeitedValue,proto3" json:"forfeited_value,omitempty"`
+}
+
+func (x *GiftCardExpiredEvent) Reset() {
+	*x = GiftCardExpiredEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GiftCardExpiredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiftCardExpiredEvent) ProtoMessage() {}
+
+func (x *GiftCardExpiredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiftCardExpiredEvent.ProtoReflect.Descriptor instead.
+func (*GiftCardExpiredEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GiftCardExpiredEvent) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GiftCardExpiredEvent) GetGiftCardId() string {
+	if x != nil {
+		return x.GiftCardId
+	}
+	return ""
+}
+
+func (x *GiftCardExpiredEvent) GetForfeitedValue() *v1.Money {
+	if x != nil {
+		return x.ForfeitedValue
+	}
+	return nil
+}
+
+// A successful payment indicates that the funds have been authorized (held).
+// The funds are captured once the order has been dispatched.
+type PaymentProcessedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int32                      `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type       PaymentProcessedEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=stocklet.events.v1.PaymentProcessedEvent_Type" json:"type,omitempty"`
+	OrderId    string                     `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string                     `protobuf:"bytes,4,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	// The amount debited (in the currency of the customer's balance)
+	Amount        *v1.Money `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	TransactionId *string   `protobuf:"bytes,6,opt,name=transaction_id,json=transactionId,proto3,oneof" json:"transaction_id,omitempty"`
+	// The amount charged for the order (in the currency of the order)
+	ChargeAmount *v1.Money `protobuf:"bytes,7,opt,name=charge_amount,json=chargeAmount,proto3" json:"charge_amount,omitempty"`
+	// The exchange rate used to convert the charge amount to the currency of the balance
+	ExchangeRate  float64          `protobuf:"fixed64,8,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	PaymentMethod v1.PaymentMethod `protobuf:"varint,9,opt,name=payment_method,json=paymentMethod,proto3,enum=stocklet.common.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Optional - Time at which the authorized hold will expire (if not captured).
+	AuthorizationExpiresAt *int64 `protobuf:"varint,10,opt,name=authorization_expires_at,json=authorizationExpiresAt,proto3,oneof" json:"authorization_expires_at,omitempty"`
+	// Set if the payment failed as a result of a risk rule.
+	RiskReason v1.PaymentRiskReason `protobuf:"varint,11,opt,name=risk_reason,json=riskReason,proto3,enum=stocklet.common.v1.PaymentRiskReason" json:"risk_reason,omitempty"`
+}
+
+func (x *PaymentProcessedEvent) Reset() {
+	*x = PaymentProcessedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentProcessedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentProcessedEvent) ProtoMessage() {}
+
+func (x *PaymentProcessedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentProcessedEvent.ProtoReflect.Descriptor instead.
+func (*PaymentProcessedEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PaymentProcessedEvent) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *PaymentProcessedEvent) GetType() PaymentProcessedEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return PaymentProcessedEvent_TYPE_UNSPECIFIED
+}
+
+func (x *PaymentProcessedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *PaymentProcessedEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *PaymentProcessedEvent) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PaymentProcessedEvent) GetTransactionId() string {
+	if x != nil && x.TransactionId != nil {
+		return *x.TransactionId
+	}
+	return ""
+}
+
+func (x *PaymentProcessedEvent) GetChargeAmount() *v1.Money {
+	if x != nil {
+		return x.ChargeAmount
+	}
+	return nil
+}
+
+func (x *PaymentProcessedEvent) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
+}
+
+func (x *PaymentProcessedEvent) GetPaymentMethod() v1.PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return v1.PaymentMethod(0)
+}
+
+func (x *PaymentProcessedEvent) GetAuthorizationExpiresAt() int64 {
+	if x != nil && x.AuthorizationExpiresAt != nil {
+		return *x.AuthorizationExpiresAt
+	}
+	return 0
+}
+
+func (x *PaymentProcessedEvent) GetRiskReason() v1.PaymentRiskReason {
+	if x != nil {
+		return x.RiskReason
+	}
+	return v1.PaymentRiskReason(0)
+}
+
+type RefundProcessedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int32                     `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Type       RefundProcessedEvent_Type `protobuf:"varint,2,opt,name=type,proto3,enum=stocklet.events.v1.RefundProcessedEvent_Type" json:"type,omitempty"`
+	ReturnId   string                    `protobuf:"bytes,3,opt,name=return_id,json=returnId,proto3" json:"return_id,omitempty"`
+	OrderId    string                    `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CustomerId string                    `protobuf:"bytes,5,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Amount     *v1.Money                 `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	RefundId   *string                   `protobuf:"bytes,7,opt,name=refund_id,json=refundId,proto3,oneof" json:"refund_id,omitempty"`
+}
+
+func (x *RefundProcessedEvent) Reset() {
+	*x = RefundProcessedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stocklet_events_v1_payment_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RefundProcessedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundProcessedEvent) ProtoMessage() {}
+
+func (x *RefundProcessedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_stocklet_events_v1_payment_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundProcessedEvent.ProtoReflect.Descriptor instead.
+func (*RefundProcessedEvent) Descriptor() ([]byte, []int) {
+	return file_stocklet_events_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RefundProcessedEvent) GetRevision() int32 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *RefundProcessedEvent) GetType() RefundProcessedEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return RefundProcessedEvent_TYPE_UNSPECIFIED
+}
+
+func (x *RefundProcessedEvent) GetReturnId() string {
+	if x != nil {
+		return x.ReturnId
+	}
+	return ""
+}
+
+func (x *RefundProcessedEvent) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RefundProcessedEvent) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *RefundProcessedEvent) GetAmount() *v1.Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *RefundProcessedEvent) GetRefundId() string {
+	if x != nil && x.RefundId != nil {
+		return *x.RefundId
+	}
+	return ""
+}
+
+var File_stocklet_events_v1_payment_proto protoreflect.FileDescriptor
+
+var file_stocklet_events_v1_payment_proto_rawDesc = []byte{
+	0x0a, 0x20, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x12, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74,
+	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a,
+	0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x22, 0xb5, 0x06, 0x0a, 0x14, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b,
+	0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6e,
+	0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x6e, 0x65, 0x77,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c,
+	0x65, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x21, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x66, 0x0a, 0x11, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x39,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x10, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x0f, 0x61,
+	0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x88, 0x01, 0x01, 0x22, 0x8d, 0x01, 0x0a, 0x06, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x55, 0x50, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54,
+	0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45,
+	0x5f, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x55,
+	0x52, 0x43, 0x45, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x44, 0x5f, 0x48, 0x4f, 0x4c,
+	0x44, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x49,
+	0x46, 0x54, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x05, 0x22, 0xbc, 0x01, 0x0a, 0x10, 0x41, 0x64,
+	0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x1d, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x57, 0x49, 0x4c, 0x4c, 0x10,
+	0x01, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x41, 0x44, 0x4a, 0x55, 0x53, 0x54, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x45, 0x4e,
+	0x53, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x13, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x44, 0x65, 0x62, 0x69, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c,
+	0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x86,
+	0x01, 0x0a, 0x12, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xca, 0x01, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
+	0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xb7, 0x02, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x65,
+	0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x79,
+	0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0d, 0x66, 0x75, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x22, 0xcc,
+	0x01, 0x0a, 0x18, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61,
+	0x70, 0x74, 0x75, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x22, 0x82, 0x03,
+	0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x69,
+	0x64, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73,
+	0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x73, 0x74, 0x6f,
+	0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x69, 0x64, 0x65,
+	0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
+	0x10, 0x03, 0x22, 0x6d, 0x0a, 0x13, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x53, 0x68, 0x69, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x22, 0xa3, 0x01, 0x0a, 0x13, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x61,
+	0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x66,
+	0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65,
+	0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x9a, 0x02, 0x0a, 0x15, 0x47, 0x69, 0x66, 0x74,
+	0x43, 0x61, 0x72, 0x64, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0c, 0x67, 0x69, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x14, 0x47, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72,
+	0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x67, 0x69, 0x66,
+	0x74, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x67, 0x69, 0x66, 0x74, 0x43, 0x61, 0x72, 0x64, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x66,
+	0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52,
+	0x0e, 0x66, 0x6f, 0x72, 0x66, 0x65, 0x69, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xb9, 0x05, 0x0a, 0x15, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x74,
+	0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x67, 0x65, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x3d, 0x0a, 0x18, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x16, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c,
+	0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0a,
+	0x72, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x02, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x42, 0x1b,
+	0x0a, 0x19, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x22, 0xf2, 0x02, 0x0a, 0x14,
+	0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x41, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2d,
+	0x2e, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x65, 0x72, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x22, 0x3f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53,
+	0x10, 0x02, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x65, 0x78, 0x6f, 0x6c, 0x61, 0x6e, 0x2f, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x6c, 0x65, 0x74, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x76, 0x31, 0x3b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_stocklet_events_v1_payment_proto_rawDescOnce sync.Once
+	file_stocklet_events_v1_payment_proto_rawDescData = file_stocklet_events_v1_payment_proto_rawDesc
+)
+
+func file_stocklet_events_v1_payment_proto_rawDescGZIP() []byte {
+	file_stocklet_events_v1_payment_proto_rawDescOnce.Do(func() {
+		file_stocklet_events_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(file_stocklet_events_v1_payment_proto_rawDescData)
+	})
+	return file_stocklet_events_v1_payment_proto_rawDescData
+}
+
+var file_stocklet_events_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_stocklet_events_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_stocklet_events_v1_payment_proto_goTypes = []interface{}{
+	(BalanceCreditedEvent_Source)(0),           // 0: stocklet.events.v1.BalanceCreditedEvent.Source
+	(BalanceCreditedEvent_AdjustmentReason)(0), // 1: stocklet.events.v1.BalanceCreditedEvent.AdjustmentReason
+	(TransactionVoidedEvent_Reason)(0),         // 2: stocklet.events.v1.TransactionVoidedEvent.Reason
+	(PaymentProcessedEvent_Type)(0),            // 3: stocklet.events.v1.PaymentProcessedEvent.Type
+	(RefundProcessedEvent_Type)(0),             // 4: stocklet.events.v1.RefundProcessedEvent.Type
+	(*BalanceCreatedEvent)(nil),                // 5: stocklet.events.v1.BalanceCreatedEvent
+	(*BalanceCreditedEvent)(nil),               // 6: stocklet.events.v1.BalanceCreditedEvent
+	(*BalanceDebitedEvent)(nil),                // 7: stocklet.events.v1.BalanceDebitedEvent
+	(*BalanceClosedEvent)(nil),                 // 8: stocklet.events.v1.BalanceClosedEvent
+	(*TransactionLoggedEvent)(nil),             // 9: stocklet.events.v1.TransactionLoggedEvent
+	(*TransactionReversedEvent)(nil),           // 10: stocklet.events.v1.TransactionReversedEvent
+	(*TransactionCapturedEvent)(nil),           // 11: stocklet.events.v1.TransactionCapturedEvent
+	(*TransactionVoidedEvent)(nil),             // 12: stocklet.events.v1.TransactionVoidedEvent
+	(*UnpaidShipmentEvent)(nil),                // 13: stocklet.events.v1.UnpaidShipmentEvent
+	(*GiftCardIssuedEvent)(nil),                // 14: stocklet.events.v1.GiftCardIssuedEvent
+	(*GiftCardRedeemedEvent)(nil),              // 15: stocklet.events.v1.GiftCardRedeemedEvent
+	(*GiftCardExpiredEvent)(nil),               // 16: stocklet.events.v1.GiftCardExpiredEvent
+	(*PaymentProcessedEvent)(nil),              // 17: stocklet.events.v1.PaymentProcessedEvent
+	(*RefundProcessedEvent)(nil),               // 18: stocklet.events.v1.RefundProcessedEvent
+	(*v1.Money)(nil),                           // 19: stocklet.common.v1.Money
+	(v1.PaymentMethod)(0),                      // 20: stocklet.common.v1.PaymentMethod
+	(v1.PaymentRiskReason)(0),                  // 21: stocklet.common.v1.PaymentRiskReason
+}
+var file_stocklet_events_v1_payment_proto_depIdxs = []int32{
+	19, // 0: stocklet.events.v1.BalanceCreatedEvent.balance:type_name -> stocklet.common.v1.Money
+	19, // 1: stocklet.events.v1.BalanceCreditedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 2: stocklet.events.v1.BalanceCreditedEvent.new_balance:type_name -> stocklet.common.v1.Money
+	0,  // 3: stocklet.events.v1.BalanceCreditedEvent.source:type_name -> stocklet.events.v1.BalanceCreditedEvent.Source
+	1,  // 4: stocklet.events.v1.BalanceCreditedEvent.adjustment_reason:type_name -> stocklet.events.v1.BalanceCreditedEvent.AdjustmentReason
+	19, // 5: stocklet.events.v1.BalanceDebitedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 6: stocklet.events.v1.BalanceDebitedEvent.new_balance:type_name -> stocklet.common.v1.Money
+	19, // 7: stocklet.events.v1.BalanceClosedEvent.balance:type_name -> stocklet.common.v1.Money
+	19, // 8: stocklet.events.v1.TransactionLoggedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 9: stocklet.events.v1.TransactionReversedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 10: stocklet.events.v1.TransactionReversedEvent.refunded_amount:type_name -> stocklet.common.v1.Money
+	19, // 11: stocklet.events.v1.TransactionCapturedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 12: stocklet.events.v1.TransactionVoidedEvent.amount:type_name -> stocklet.common.v1.Money
+	2,  // 13: stocklet.events.v1.TransactionVoidedEvent.reason:type_name -> stocklet.events.v1.TransactionVoidedEvent.Reason
+	19, // 14: stocklet.events.v1.GiftCardIssuedEvent.value:type_name -> stocklet.common.v1.Money
+	19, // 15: stocklet.events.v1.GiftCardRedeemedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 16: stocklet.events.v1.GiftCardRedeemedEvent.remaining_value:type_name -> stocklet.common.v1.Money
+	19, // 17: stocklet.events.v1.GiftCardExpiredEvent.forfeited_value:type_name -> stocklet.common.v1.Money
+	3,  // 18: stocklet.events.v1.PaymentProcessedEvent.type:type_name -> stocklet.events.v1.PaymentProcessedEvent.Type
+	19, // 19: stocklet.events.v1.PaymentProcessedEvent.amount:type_name -> stocklet.common.v1.Money
+	19, // 20: stocklet.events.v1.PaymentProcessedEvent.charge_amount:type_name -> stocklet.common.v1.Money
+	20, // 21: stocklet.events.v1.PaymentProcessedEvent.payment_method:type_name -> stocklet.common.v1.PaymentMethod
+	21, // 22: stocklet.events.v1.PaymentProcessedEvent.risk_reason:type_name -> stocklet.common.v1.PaymentRiskReason
+	4,  // 23: stocklet.events.v1.RefundProcessedEvent.type:type_name -> stocklet.events.v1.RefundProcessedEvent.Type
+	19, // 24: stocklet.events.v1.RefundProcessedEvent.amount:type_name -> stocklet.common.v1.Money
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_stocklet_events_v1_payment_proto_init() }
+func file_stocklet_events_v1_payment_proto_init() {
+	if File_stocklet_events_v1_payment_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
 		file_stocklet_events_v1_payment_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BalanceCreatedEvent); i {
 			case 0:
@@ -798,6 +1894,78 @@ func file_stocklet_events_v1_payment_proto_init() {
 			}
 		}
 		file_stocklet_events_v1_payment_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionCapturedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocklet_events_v1_payment_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionVoidedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocklet_events_v1_payment_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpaidShipmentEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocklet_events_v1_payment_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardIssuedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocklet_events_v1_payment_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardRedeemedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocklet_events_v1_payment_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GiftCardExpiredEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_stocklet_events_v1_payment_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PaymentProcessedEvent); i {
 			case 0:
 				return &v.state
@@ -809,15 +1977,30 @@ func file_stocklet_events_v1_payment_proto_init() {
 				return nil
 			}
 		}
+		file_stocklet_events_v1_payment_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RefundProcessedEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_stocklet_events_v1_payment_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_stocklet_events_v1_payment_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_stocklet_events_v1_payment_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_stocklet_events_v1_payment_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_stocklet_events_v1_payment_proto_msgTypes[13].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stocklet_events_v1_payment_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
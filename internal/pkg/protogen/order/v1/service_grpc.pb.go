@@ -37,14 +37,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	OrderService_ServiceInfo_FullMethodName                    = "/stocklet.order.v1.OrderService/ServiceInfo"
-	OrderService_ViewOrder_FullMethodName                      = "/stocklet.order.v1.OrderService/ViewOrder"
-	OrderService_ViewOrders_FullMethodName                     = "/stocklet.order.v1.OrderService/ViewOrders"
-	OrderService_PlaceOrder_FullMethodName                     = "/stocklet.order.v1.OrderService/PlaceOrder"
-	OrderService_ProcessProductPriceQuoteEvent_FullMethodName  = "/stocklet.order.v1.OrderService/ProcessProductPriceQuoteEvent"
-	OrderService_ProcessStockReservationEvent_FullMethodName   = "/stocklet.order.v1.OrderService/ProcessStockReservationEvent"
-	OrderService_ProcessShipmentAllocationEvent_FullMethodName = "/stocklet.order.v1.OrderService/ProcessShipmentAllocationEvent"
-	OrderService_ProcessPaymentProcessedEvent_FullMethodName   = "/stocklet.order.v1.OrderService/ProcessPaymentProcessedEvent"
+	OrderService_ServiceInfo_FullMethodName                     = "/stocklet.order.v1.OrderService/ServiceInfo"
+	OrderService_ViewOrder_FullMethodName                       = "/stocklet.order.v1.OrderService/ViewOrder"
+	OrderService_ViewOrders_FullMethodName                      = "/stocklet.order.v1.OrderService/ViewOrders"
+	OrderService_WatchOrder_FullMethodName                      = "/stocklet.order.v1.OrderService/WatchOrder"
+	OrderService_PlaceOrder_FullMethodName                      = "/stocklet.order.v1.OrderService/PlaceOrder"
+	OrderService_OpenReturn_FullMethodName                      = "/stocklet.order.v1.OrderService/OpenReturn"
+	OrderService_ViewReturn_FullMethodName                      = "/stocklet.order.v1.OrderService/ViewReturn"
+	OrderService_ViewOrderReturns_FullMethodName                = "/stocklet.order.v1.OrderService/ViewOrderReturns"
+	OrderService_ApproveReturn_FullMethodName                   = "/stocklet.order.v1.OrderService/ApproveReturn"
+	OrderService_RejectReturn_FullMethodName                    = "/stocklet.order.v1.OrderService/RejectReturn"
+	OrderService_ProcessProductPriceQuoteEvent_FullMethodName   = "/stocklet.order.v1.OrderService/ProcessProductPriceQuoteEvent"
+	OrderService_ProcessStockReservationEvent_FullMethodName    = "/stocklet.order.v1.OrderService/ProcessStockReservationEvent"
+	OrderService_ProcessShipmentAllocationEvent_FullMethodName  = "/stocklet.order.v1.OrderService/ProcessShipmentAllocationEvent"
+	OrderService_ProcessPaymentProcessedEvent_FullMethodName    = "/stocklet.order.v1.OrderService/ProcessPaymentProcessedEvent"
+	OrderService_ProcessStockAddedEvent_FullMethodName          = "/stocklet.order.v1.OrderService/ProcessStockAddedEvent"
+	OrderService_ProcessRefundProcessedEvent_FullMethodName     = "/stocklet.order.v1.OrderService/ProcessRefundProcessedEvent"
+	OrderService_ProcessTransactionCapturedEvent_FullMethodName = "/stocklet.order.v1.OrderService/ProcessTransactionCapturedEvent"
+	OrderService_ProcessTransactionVoidedEvent_FullMethodName   = "/stocklet.order.v1.OrderService/ProcessTransactionVoidedEvent"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -59,7 +69,26 @@ type OrderServiceClient interface {
 	// Get a list of a customer's orders.
 	// If accessed through the gateway - shows the current user's orders.
 	ViewOrders(ctx context.Context, in *ViewOrdersRequest, opts ...grpc.CallOption) (*ViewOrdersResponse, error)
+	// Stream status updates of an order.
+	//
+	// The current state of the order is sent upon connection, followed by the
+	// order upon every status change (until it reaches a final status).
+	//
+	// Through the gateway, updates are streamed as newline delimited JSON,
+	// or as Server-Sent Events (if requested with 'Accept: text/event-stream').
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (OrderService_WatchOrderClient, error)
 	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+	// Open a return against the items of a completed order.
+	OpenReturn(ctx context.Context, in *OpenReturnRequest, opts ...grpc.CallOption) (*OpenReturnResponse, error)
+	ViewReturn(ctx context.Context, in *ViewReturnRequest, opts ...grpc.CallOption) (*ViewReturnResponse, error)
+	// Get a list of the returns opened against an order.
+	ViewOrderReturns(ctx context.Context, in *ViewOrderReturnsRequest, opts ...grpc.CallOption) (*ViewOrderReturnsResponse, error)
+	// Approve a requested return (admin only).
+	//
+	// The returned items are then restocked, and the line amounts refunded.
+	ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*ApproveReturnResponse, error)
+	// Reject a requested return (admin only).
+	RejectReturn(ctx context.Context, in *RejectReturnRequest, opts ...grpc.CallOption) (*RejectReturnResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -84,6 +113,27 @@ type OrderServiceClient interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessPaymentProcessedEvent(ctx context.Context, in *v11.PaymentProcessedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessStockAddedEvent(ctx context.Context, in *v11.StockAddedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessRefundProcessedEvent(ctx context.Context, in *v11.RefundProcessedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessTransactionCapturedEvent(ctx context.Context, in *v11.TransactionCapturedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessTransactionVoidedEvent(ctx context.Context, in *v11.TransactionVoidedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type orderServiceClient struct {
@@ -121,6 +171,38 @@ func (c *orderServiceClient) ViewOrders(ctx context.Context, in *ViewOrdersReque
 	return out, nil
 }
 
+func (c *orderServiceClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (OrderService_WatchOrderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_WatchOrder_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderServiceWatchOrderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrderService_WatchOrderClient interface {
+	Recv() (*WatchOrderResponse, error)
+	grpc.ClientStream
+}
+
+type orderServiceWatchOrderClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderServiceWatchOrderClient) Recv() (*WatchOrderResponse, error) {
+	m := new(WatchOrderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *orderServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error) {
 	out := new(PlaceOrderResponse)
 	err := c.cc.Invoke(ctx, OrderService_PlaceOrder_FullMethodName, in, out, opts...)
@@ -130,6 +212,51 @@ func (c *orderServiceClient) PlaceOrder(ctx context.Context, in *PlaceOrderReque
 	return out, nil
 }
 
+func (c *orderServiceClient) OpenReturn(ctx context.Context, in *OpenReturnRequest, opts ...grpc.CallOption) (*OpenReturnResponse, error) {
+	out := new(OpenReturnResponse)
+	err := c.cc.Invoke(ctx, OrderService_OpenReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ViewReturn(ctx context.Context, in *ViewReturnRequest, opts ...grpc.CallOption) (*ViewReturnResponse, error) {
+	out := new(ViewReturnResponse)
+	err := c.cc.Invoke(ctx, OrderService_ViewReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ViewOrderReturns(ctx context.Context, in *ViewOrderReturnsRequest, opts ...grpc.CallOption) (*ViewOrderReturnsResponse, error) {
+	out := new(ViewOrderReturnsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ViewOrderReturns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ApproveReturn(ctx context.Context, in *ApproveReturnRequest, opts ...grpc.CallOption) (*ApproveReturnResponse, error) {
+	out := new(ApproveReturnResponse)
+	err := c.cc.Invoke(ctx, OrderService_ApproveReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RejectReturn(ctx context.Context, in *RejectReturnRequest, opts ...grpc.CallOption) (*RejectReturnResponse, error) {
+	out := new(RejectReturnResponse)
+	err := c.cc.Invoke(ctx, OrderService_RejectReturn_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderServiceClient) ProcessProductPriceQuoteEvent(ctx context.Context, in *v11.ProductPriceQuoteEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, OrderService_ProcessProductPriceQuoteEvent_FullMethodName, in, out, opts...)
@@ -166,6 +293,42 @@ func (c *orderServiceClient) ProcessPaymentProcessedEvent(ctx context.Context, i
 	return out, nil
 }
 
+func (c *orderServiceClient) ProcessStockAddedEvent(ctx context.Context, in *v11.StockAddedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ProcessStockAddedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ProcessRefundProcessedEvent(ctx context.Context, in *v11.RefundProcessedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ProcessRefundProcessedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ProcessTransactionCapturedEvent(ctx context.Context, in *v11.TransactionCapturedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ProcessTransactionCapturedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ProcessTransactionVoidedEvent(ctx context.Context, in *v11.TransactionVoidedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, OrderService_ProcessTransactionVoidedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility
@@ -178,7 +341,26 @@ type OrderServiceServer interface {
 	// Get a list of a customer's orders.
 	// If accessed through the gateway - shows the current user's orders.
 	ViewOrders(context.Context, *ViewOrdersRequest) (*ViewOrdersResponse, error)
+	// Stream status updates of an order.
+	//
+	// The current state of the order is sent upon connection, followed by the
+	// order upon every status change (until it reaches a final status).
+	//
+	// Through the gateway, updates are streamed as newline delimited JSON,
+	// or as Server-Sent Events (if requested with 'Accept: text/event-stream').
+	WatchOrder(*WatchOrderRequest, OrderService_WatchOrderServer) error
 	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	// Open a return against the items of a completed order.
+	OpenReturn(context.Context, *OpenReturnRequest) (*OpenReturnResponse, error)
+	ViewReturn(context.Context, *ViewReturnRequest) (*ViewReturnResponse, error)
+	// Get a list of the returns opened against an order.
+	ViewOrderReturns(context.Context, *ViewOrderReturnsRequest) (*ViewOrderReturnsResponse, error)
+	// Approve a requested return (admin only).
+	//
+	// The returned items are then restocked, and the line amounts refunded.
+	ApproveReturn(context.Context, *ApproveReturnRequest) (*ApproveReturnResponse, error)
+	// Reject a requested return (admin only).
+	RejectReturn(context.Context, *RejectReturnRequest) (*RejectReturnResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -203,6 +385,27 @@ type OrderServiceServer interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessPaymentProcessedEvent(context.Context, *v11.PaymentProcessedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessStockAddedEvent(context.Context, *v11.StockAddedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessRefundProcessedEvent(context.Context, *v11.RefundProcessedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessTransactionCapturedEvent(context.Context, *v11.TransactionCapturedEvent) (*emptypb.Empty, error)
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessTransactionVoidedEvent(context.Context, *v11.TransactionVoidedEvent) (*emptypb.Empty, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -219,9 +422,27 @@ func (UnimplementedOrderServiceServer) ViewOrder(context.Context, *ViewOrderRequ
 func (UnimplementedOrderServiceServer) ViewOrders(context.Context, *ViewOrdersRequest) (*ViewOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) WatchOrder(*WatchOrderRequest, OrderService_WatchOrderServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
 }
+func (UnimplementedOrderServiceServer) OpenReturn(context.Context, *OpenReturnRequest) (*OpenReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenReturn not implemented")
+}
+func (UnimplementedOrderServiceServer) ViewReturn(context.Context, *ViewReturnRequest) (*ViewReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewReturn not implemented")
+}
+func (UnimplementedOrderServiceServer) ViewOrderReturns(context.Context, *ViewOrderReturnsRequest) (*ViewOrderReturnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewOrderReturns not implemented")
+}
+func (UnimplementedOrderServiceServer) ApproveReturn(context.Context, *ApproveReturnRequest) (*ApproveReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveReturn not implemented")
+}
+func (UnimplementedOrderServiceServer) RejectReturn(context.Context, *RejectReturnRequest) (*RejectReturnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectReturn not implemented")
+}
 func (UnimplementedOrderServiceServer) ProcessProductPriceQuoteEvent(context.Context, *v11.ProductPriceQuoteEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessProductPriceQuoteEvent not implemented")
 }
@@ -234,6 +455,18 @@ func (UnimplementedOrderServiceServer) ProcessShipmentAllocationEvent(context.Co
 func (UnimplementedOrderServiceServer) ProcessPaymentProcessedEvent(context.Context, *v11.PaymentProcessedEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPaymentProcessedEvent not implemented")
 }
+func (UnimplementedOrderServiceServer) ProcessStockAddedEvent(context.Context, *v11.StockAddedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessStockAddedEvent not implemented")
+}
+func (UnimplementedOrderServiceServer) ProcessRefundProcessedEvent(context.Context, *v11.RefundProcessedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessRefundProcessedEvent not implemented")
+}
+func (UnimplementedOrderServiceServer) ProcessTransactionCapturedEvent(context.Context, *v11.TransactionCapturedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTransactionCapturedEvent not implemented")
+}
+func (UnimplementedOrderServiceServer) ProcessTransactionVoidedEvent(context.Context, *v11.TransactionVoidedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTransactionVoidedEvent not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 
 // UnsafeOrderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -301,6 +534,27 @@ func _OrderService_ViewOrders_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).WatchOrder(m, &orderServiceWatchOrderServer{stream})
+}
+
+type OrderService_WatchOrderServer interface {
+	Send(*WatchOrderResponse) error
+	grpc.ServerStream
+}
+
+type orderServiceWatchOrderServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderServiceWatchOrderServer) Send(m *WatchOrderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _OrderService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PlaceOrderRequest)
 	if err := dec(in); err != nil {
@@ -319,6 +573,96 @@ func _OrderService_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_OpenReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).OpenReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_OpenReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).OpenReturn(ctx, req.(*OpenReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ViewReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ViewReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ViewReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ViewReturn(ctx, req.(*ViewReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ViewOrderReturns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewOrderReturnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ViewOrderReturns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ViewOrderReturns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ViewOrderReturns(ctx, req.(*ViewOrderReturnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ApproveReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ApproveReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ApproveReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ApproveReturn(ctx, req.(*ApproveReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RejectReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RejectReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RejectReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RejectReturn(ctx, req.(*RejectReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_ProcessProductPriceQuoteEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v11.ProductPriceQuoteEvent)
 	if err := dec(in); err != nil {
@@ -391,6 +735,78 @@ func _OrderService_ProcessPaymentProcessedEvent_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_ProcessStockAddedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.StockAddedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ProcessStockAddedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ProcessStockAddedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ProcessStockAddedEvent(ctx, req.(*v11.StockAddedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ProcessRefundProcessedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.RefundProcessedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ProcessRefundProcessedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ProcessRefundProcessedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ProcessRefundProcessedEvent(ctx, req.(*v11.RefundProcessedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ProcessTransactionCapturedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.TransactionCapturedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ProcessTransactionCapturedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ProcessTransactionCapturedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ProcessTransactionCapturedEvent(ctx, req.(*v11.TransactionCapturedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ProcessTransactionVoidedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.TransactionVoidedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ProcessTransactionVoidedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ProcessTransactionVoidedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ProcessTransactionVoidedEvent(ctx, req.(*v11.TransactionVoidedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -414,6 +830,26 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PlaceOrder",
 			Handler:    _OrderService_PlaceOrder_Handler,
 		},
+		{
+			MethodName: "OpenReturn",
+			Handler:    _OrderService_OpenReturn_Handler,
+		},
+		{
+			MethodName: "ViewReturn",
+			Handler:    _OrderService_ViewReturn_Handler,
+		},
+		{
+			MethodName: "ViewOrderReturns",
+			Handler:    _OrderService_ViewOrderReturns_Handler,
+		},
+		{
+			MethodName: "ApproveReturn",
+			Handler:    _OrderService_ApproveReturn_Handler,
+		},
+		{
+			MethodName: "RejectReturn",
+			Handler:    _OrderService_RejectReturn_Handler,
+		},
 		{
 			MethodName: "ProcessProductPriceQuoteEvent",
 			Handler:    _OrderService_ProcessProductPriceQuoteEvent_Handler,
@@ -430,7 +866,29 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPaymentProcessedEvent",
 			Handler:    _OrderService_ProcessPaymentProcessedEvent_Handler,
 		},
+		{
+			MethodName: "ProcessStockAddedEvent",
+			Handler:    _OrderService_ProcessStockAddedEvent_Handler,
+		},
+		{
+			MethodName: "ProcessRefundProcessedEvent",
+			Handler:    _OrderService_ProcessRefundProcessedEvent_Handler,
+		},
+		{
+			MethodName: "ProcessTransactionCapturedEvent",
+			Handler:    _OrderService_ProcessTransactionCapturedEvent_Handler,
+		},
+		{
+			MethodName: "ProcessTransactionVoidedEvent",
+			Handler:    _OrderService_ProcessTransactionVoidedEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrderService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "stocklet/order/v1/service.proto",
 }
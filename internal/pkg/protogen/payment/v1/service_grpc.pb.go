@@ -39,10 +39,27 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	PaymentService_ServiceInfo_FullMethodName                    = "/stocklet.payment.v1.PaymentService/ServiceInfo"
 	PaymentService_ViewTransaction_FullMethodName                = "/stocklet.payment.v1.PaymentService/ViewTransaction"
+	PaymentService_ListTransactions_FullMethodName               = "/stocklet.payment.v1.PaymentService/ListTransactions"
 	PaymentService_ViewBalance_FullMethodName                    = "/stocklet.payment.v1.PaymentService/ViewBalance"
+	PaymentService_TopUpBalance_FullMethodName                   = "/stocklet.payment.v1.PaymentService/TopUpBalance"
+	PaymentService_AdjustBalance_FullMethodName                  = "/stocklet.payment.v1.PaymentService/AdjustBalance"
+	PaymentService_RefundTransaction_FullMethodName              = "/stocklet.payment.v1.PaymentService/RefundTransaction"
+	PaymentService_IssueGiftCard_FullMethodName                  = "/stocklet.payment.v1.PaymentService/IssueGiftCard"
+	PaymentService_ViewGiftCard_FullMethodName                   = "/stocklet.payment.v1.PaymentService/ViewGiftCard"
+	PaymentService_RedeemGiftCard_FullMethodName                 = "/stocklet.payment.v1.PaymentService/RedeemGiftCard"
+	PaymentService_ViewStatement_FullMethodName                  = "/stocklet.payment.v1.PaymentService/ViewStatement"
+	PaymentService_ListStatements_FullMethodName                 = "/stocklet.payment.v1.PaymentService/ListStatements"
+	PaymentService_GenerateStatement_FullMethodName              = "/stocklet.payment.v1.PaymentService/GenerateStatement"
+	PaymentService_EvaluatePaymentRisk_FullMethodName            = "/stocklet.payment.v1.PaymentService/EvaluatePaymentRisk"
+	PaymentService_HoldPaymentToken_FullMethodName               = "/stocklet.payment.v1.PaymentService/HoldPaymentToken"
+	PaymentService_ReleasePaymentToken_FullMethodName            = "/stocklet.payment.v1.PaymentService/ReleasePaymentToken"
+	PaymentService_CheckOrderPayment_FullMethodName              = "/stocklet.payment.v1.PaymentService/CheckOrderPayment"
 	PaymentService_ProcessUserCreatedEvent_FullMethodName        = "/stocklet.payment.v1.PaymentService/ProcessUserCreatedEvent"
 	PaymentService_ProcessUserDeletedEvent_FullMethodName        = "/stocklet.payment.v1.PaymentService/ProcessUserDeletedEvent"
 	PaymentService_ProcessShipmentAllocationEvent_FullMethodName = "/stocklet.payment.v1.PaymentService/ProcessShipmentAllocationEvent"
+	PaymentService_ProcessReturnApprovedEvent_FullMethodName     = "/stocklet.payment.v1.PaymentService/ProcessReturnApprovedEvent"
+	PaymentService_ProcessShipmentDispatchedEvent_FullMethodName = "/stocklet.payment.v1.PaymentService/ProcessShipmentDispatchedEvent"
+	PaymentService_ProcessOrderRejectedEvent_FullMethodName      = "/stocklet.payment.v1.PaymentService/ProcessOrderRejectedEvent"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -54,7 +71,48 @@ type PaymentServiceClient interface {
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ServiceInfo(ctx context.Context, in *v1.ServiceInfoRequest, opts ...grpc.CallOption) (*v1.ServiceInfoResponse, error)
 	ViewTransaction(ctx context.Context, in *ViewTransactionRequest, opts ...grpc.CallOption) (*ViewTransactionResponse, error)
+	// List a customer's transactions (most recent first).
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
 	ViewBalance(ctx context.Context, in *ViewBalanceRequest, opts ...grpc.CallOption) (*ViewBalanceResponse, error)
+	// Top up a customer's balance by charging the funding source.
+	TopUpBalance(ctx context.Context, in *TopUpBalanceRequest, opts ...grpc.CallOption) (*TopUpBalanceResponse, error)
+	// Credit a customer's balance, with a reason for the adjustment (admin only).
+	AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error)
+	// Refund a transaction to the customer's balance (admin only).
+	//
+	// Transactions can be refunded in full, or partially over multiple refunds.
+	RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*RefundTransactionResponse, error)
+	// Issue a gift card with a value (admin only).
+	IssueGiftCard(ctx context.Context, in *IssueGiftCardRequest, opts ...grpc.CallOption) (*IssueGiftCardResponse, error)
+	ViewGiftCard(ctx context.Context, in *ViewGiftCardRequest, opts ...grpc.CallOption) (*ViewGiftCardResponse, error)
+	// Redeem the remaining value of a gift card into a customer's balance.
+	RedeemGiftCard(ctx context.Context, in *RedeemGiftCardRequest, opts ...grpc.CallOption) (*RedeemGiftCardResponse, error)
+	// View a statement, optionally rendered as a PDF or CSV document.
+	ViewStatement(ctx context.Context, in *ViewStatementRequest, opts ...grpc.CallOption) (*ViewStatementResponse, error)
+	// List a customer's statements (most recent first).
+	ListStatements(ctx context.Context, in *ListStatementsRequest, opts ...grpc.CallOption) (*ListStatementsResponse, error)
+	// Generate a customer's statement for a month (admin only).
+	//
+	// Statements are otherwise generated automatically once each month has ended.
+	GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error)
+	// Evaluate the risk rules against a prospective payment, without taking payment.
+	//
+	// Intended for support staff (requires an elevated role through the gateway).
+	EvaluatePaymentRisk(ctx context.Context, in *EvaluatePaymentRiskRequest, opts ...grpc.CallOption) (*EvaluatePaymentRiskResponse, error)
+	// Hold the payment token provided with an order (internal only).
+	//
+	// A reference is returned in place of the token, which is passed on with
+	// the order and resolved when the payment for the order is authorized.
+	HoldPaymentToken(ctx context.Context, in *HoldPaymentTokenRequest, opts ...grpc.CallOption) (*HoldPaymentTokenResponse, error)
+	// Release the payment tokens held for an order (internal only).
+	//
+	// Used when the order could not be placed after its token was held.
+	ReleasePaymentToken(ctx context.Context, in *ReleasePaymentTokenRequest, opts ...grpc.CallOption) (*ReleasePaymentTokenResponse, error)
+	// Check whether the payment for an order is held or captured (internal only).
+	//
+	// Used by the shipping service to resolve the payment status of shipments
+	// allocated before payment authorizations were tracked.
+	CheckOrderPayment(ctx context.Context, in *CheckOrderPaymentRequest, opts ...grpc.CallOption) (*CheckOrderPaymentResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -73,6 +131,24 @@ type PaymentServiceClient interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessShipmentAllocationEvent(ctx context.Context, in *v11.ShipmentAllocationEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessReturnApprovedEvent(ctx context.Context, in *v11.ReturnApprovedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessShipmentDispatchedEvent(ctx context.Context, in *v11.ShipmentDispatchedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessOrderRejectedEvent(ctx context.Context, in *v11.OrderRejectedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type paymentServiceClient struct {
@@ -101,6 +177,15 @@ func (c *paymentServiceClient) ViewTransaction(ctx context.Context, in *ViewTran
 	return out, nil
 }
 
+func (c *paymentServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) ViewBalance(ctx context.Context, in *ViewBalanceRequest, opts ...grpc.CallOption) (*ViewBalanceResponse, error) {
 	out := new(ViewBalanceResponse)
 	err := c.cc.Invoke(ctx, PaymentService_ViewBalance_FullMethodName, in, out, opts...)
@@ -110,6 +195,123 @@ func (c *paymentServiceClient) ViewBalance(ctx context.Context, in *ViewBalanceR
 	return out, nil
 }
 
+func (c *paymentServiceClient) TopUpBalance(ctx context.Context, in *TopUpBalanceRequest, opts ...grpc.CallOption) (*TopUpBalanceResponse, error) {
+	out := new(TopUpBalanceResponse)
+	err := c.cc.Invoke(ctx, PaymentService_TopUpBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) AdjustBalance(ctx context.Context, in *AdjustBalanceRequest, opts ...grpc.CallOption) (*AdjustBalanceResponse, error) {
+	out := new(AdjustBalanceResponse)
+	err := c.cc.Invoke(ctx, PaymentService_AdjustBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*RefundTransactionResponse, error) {
+	out := new(RefundTransactionResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RefundTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) IssueGiftCard(ctx context.Context, in *IssueGiftCardRequest, opts ...grpc.CallOption) (*IssueGiftCardResponse, error) {
+	out := new(IssueGiftCardResponse)
+	err := c.cc.Invoke(ctx, PaymentService_IssueGiftCard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ViewGiftCard(ctx context.Context, in *ViewGiftCardRequest, opts ...grpc.CallOption) (*ViewGiftCardResponse, error) {
+	out := new(ViewGiftCardResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ViewGiftCard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) RedeemGiftCard(ctx context.Context, in *RedeemGiftCardRequest, opts ...grpc.CallOption) (*RedeemGiftCardResponse, error) {
+	out := new(RedeemGiftCardResponse)
+	err := c.cc.Invoke(ctx, PaymentService_RedeemGiftCard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ViewStatement(ctx context.Context, in *ViewStatementRequest, opts ...grpc.CallOption) (*ViewStatementResponse, error) {
+	out := new(ViewStatementResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ViewStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListStatements(ctx context.Context, in *ListStatementsRequest, opts ...grpc.CallOption) (*ListStatementsResponse, error) {
+	out := new(ListStatementsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListStatements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GenerateStatement(ctx context.Context, in *GenerateStatementRequest, opts ...grpc.CallOption) (*GenerateStatementResponse, error) {
+	out := new(GenerateStatementResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GenerateStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) EvaluatePaymentRisk(ctx context.Context, in *EvaluatePaymentRiskRequest, opts ...grpc.CallOption) (*EvaluatePaymentRiskResponse, error) {
+	out := new(EvaluatePaymentRiskResponse)
+	err := c.cc.Invoke(ctx, PaymentService_EvaluatePaymentRisk_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) HoldPaymentToken(ctx context.Context, in *HoldPaymentTokenRequest, opts ...grpc.CallOption) (*HoldPaymentTokenResponse, error) {
+	out := new(HoldPaymentTokenResponse)
+	err := c.cc.Invoke(ctx, PaymentService_HoldPaymentToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ReleasePaymentToken(ctx context.Context, in *ReleasePaymentTokenRequest, opts ...grpc.CallOption) (*ReleasePaymentTokenResponse, error) {
+	out := new(ReleasePaymentTokenResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ReleasePaymentToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) CheckOrderPayment(ctx context.Context, in *CheckOrderPaymentRequest, opts ...grpc.CallOption) (*CheckOrderPaymentResponse, error) {
+	out := new(CheckOrderPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_CheckOrderPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *paymentServiceClient) ProcessUserCreatedEvent(ctx context.Context, in *v11.UserCreatedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, PaymentService_ProcessUserCreatedEvent_FullMethodName, in, out, opts...)
@@ -137,6 +339,33 @@ func (c *paymentServiceClient) ProcessShipmentAllocationEvent(ctx context.Contex
 	return out, nil
 }
 
+func (c *paymentServiceClient) ProcessReturnApprovedEvent(ctx context.Context, in *v11.ReturnApprovedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_ProcessReturnApprovedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ProcessShipmentDispatchedEvent(ctx context.Context, in *v11.ShipmentDispatchedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_ProcessShipmentDispatchedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ProcessOrderRejectedEvent(ctx context.Context, in *v11.OrderRejectedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PaymentService_ProcessOrderRejectedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility
@@ -146,7 +375,48 @@ type PaymentServiceServer interface {
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ServiceInfo(context.Context, *v1.ServiceInfoRequest) (*v1.ServiceInfoResponse, error)
 	ViewTransaction(context.Context, *ViewTransactionRequest) (*ViewTransactionResponse, error)
+	// List a customer's transactions (most recent first).
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
 	ViewBalance(context.Context, *ViewBalanceRequest) (*ViewBalanceResponse, error)
+	// Top up a customer's balance by charging the funding source.
+	TopUpBalance(context.Context, *TopUpBalanceRequest) (*TopUpBalanceResponse, error)
+	// Credit a customer's balance, with a reason for the adjustment (admin only).
+	AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error)
+	// Refund a transaction to the customer's balance (admin only).
+	//
+	// Transactions can be refunded in full, or partially over multiple refunds.
+	RefundTransaction(context.Context, *RefundTransactionRequest) (*RefundTransactionResponse, error)
+	// Issue a gift card with a value (admin only).
+	IssueGiftCard(context.Context, *IssueGiftCardRequest) (*IssueGiftCardResponse, error)
+	ViewGiftCard(context.Context, *ViewGiftCardRequest) (*ViewGiftCardResponse, error)
+	// Redeem the remaining value of a gift card into a customer's balance.
+	RedeemGiftCard(context.Context, *RedeemGiftCardRequest) (*RedeemGiftCardResponse, error)
+	// View a statement, optionally rendered as a PDF or CSV document.
+	ViewStatement(context.Context, *ViewStatementRequest) (*ViewStatementResponse, error)
+	// List a customer's statements (most recent first).
+	ListStatements(context.Context, *ListStatementsRequest) (*ListStatementsResponse, error)
+	// Generate a customer's statement for a month (admin only).
+	//
+	// Statements are otherwise generated automatically once each month has ended.
+	GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error)
+	// Evaluate the risk rules against a prospective payment, without taking payment.
+	//
+	// Intended for support staff (requires an elevated role through the gateway).
+	EvaluatePaymentRisk(context.Context, *EvaluatePaymentRiskRequest) (*EvaluatePaymentRiskResponse, error)
+	// Hold the payment token provided with an order (internal only).
+	//
+	// A reference is returned in place of the token, which is passed on with
+	// the order and resolved when the payment for the order is authorized.
+	HoldPaymentToken(context.Context, *HoldPaymentTokenRequest) (*HoldPaymentTokenResponse, error)
+	// Release the payment tokens held for an order (internal only).
+	//
+	// Used when the order could not be placed after its token was held.
+	ReleasePaymentToken(context.Context, *ReleasePaymentTokenRequest) (*ReleasePaymentTokenResponse, error)
+	// Check whether the payment for an order is held or captured (internal only).
+	//
+	// Used by the shipping service to resolve the payment status of shipments
+	// allocated before payment authorizations were tracked.
+	CheckOrderPayment(context.Context, *CheckOrderPaymentRequest) (*CheckOrderPaymentResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -165,6 +435,24 @@ type PaymentServiceServer interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessShipmentAllocationEvent(context.Context, *v11.ShipmentAllocationEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessReturnApprovedEvent(context.Context, *v11.ReturnApprovedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessShipmentDispatchedEvent(context.Context, *v11.ShipmentDispatchedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessOrderRejectedEvent(context.Context, *v11.OrderRejectedEvent) (*emptypb.Empty, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -178,9 +466,51 @@ func (UnimplementedPaymentServiceServer) ServiceInfo(context.Context, *v1.Servic
 func (UnimplementedPaymentServiceServer) ViewTransaction(context.Context, *ViewTransactionRequest) (*ViewTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewTransaction not implemented")
 }
+func (UnimplementedPaymentServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
 func (UnimplementedPaymentServiceServer) ViewBalance(context.Context, *ViewBalanceRequest) (*ViewBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewBalance not implemented")
 }
+func (UnimplementedPaymentServiceServer) TopUpBalance(context.Context, *TopUpBalanceRequest) (*TopUpBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpBalance not implemented")
+}
+func (UnimplementedPaymentServiceServer) AdjustBalance(context.Context, *AdjustBalanceRequest) (*AdjustBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustBalance not implemented")
+}
+func (UnimplementedPaymentServiceServer) RefundTransaction(context.Context, *RefundTransactionRequest) (*RefundTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTransaction not implemented")
+}
+func (UnimplementedPaymentServiceServer) IssueGiftCard(context.Context, *IssueGiftCardRequest) (*IssueGiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueGiftCard not implemented")
+}
+func (UnimplementedPaymentServiceServer) ViewGiftCard(context.Context, *ViewGiftCardRequest) (*ViewGiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewGiftCard not implemented")
+}
+func (UnimplementedPaymentServiceServer) RedeemGiftCard(context.Context, *RedeemGiftCardRequest) (*RedeemGiftCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemGiftCard not implemented")
+}
+func (UnimplementedPaymentServiceServer) ViewStatement(context.Context, *ViewStatementRequest) (*ViewStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewStatement not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListStatements(context.Context, *ListStatementsRequest) (*ListStatementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStatements not implemented")
+}
+func (UnimplementedPaymentServiceServer) GenerateStatement(context.Context, *GenerateStatementRequest) (*GenerateStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateStatement not implemented")
+}
+func (UnimplementedPaymentServiceServer) EvaluatePaymentRisk(context.Context, *EvaluatePaymentRiskRequest) (*EvaluatePaymentRiskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePaymentRisk not implemented")
+}
+func (UnimplementedPaymentServiceServer) HoldPaymentToken(context.Context, *HoldPaymentTokenRequest) (*HoldPaymentTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HoldPaymentToken not implemented")
+}
+func (UnimplementedPaymentServiceServer) ReleasePaymentToken(context.Context, *ReleasePaymentTokenRequest) (*ReleasePaymentTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleasePaymentToken not implemented")
+}
+func (UnimplementedPaymentServiceServer) CheckOrderPayment(context.Context, *CheckOrderPaymentRequest) (*CheckOrderPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOrderPayment not implemented")
+}
 func (UnimplementedPaymentServiceServer) ProcessUserCreatedEvent(context.Context, *v11.UserCreatedEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessUserCreatedEvent not implemented")
 }
@@ -190,6 +520,15 @@ func (UnimplementedPaymentServiceServer) ProcessUserDeletedEvent(context.Context
 func (UnimplementedPaymentServiceServer) ProcessShipmentAllocationEvent(context.Context, *v11.ShipmentAllocationEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessShipmentAllocationEvent not implemented")
 }
+func (UnimplementedPaymentServiceServer) ProcessReturnApprovedEvent(context.Context, *v11.ReturnApprovedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessReturnApprovedEvent not implemented")
+}
+func (UnimplementedPaymentServiceServer) ProcessShipmentDispatchedEvent(context.Context, *v11.ShipmentDispatchedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessShipmentDispatchedEvent not implemented")
+}
+func (UnimplementedPaymentServiceServer) ProcessOrderRejectedEvent(context.Context, *v11.OrderRejectedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessOrderRejectedEvent not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 
 // UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -239,6 +578,24 @@ func _PaymentService_ViewTransaction_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_ViewBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ViewBalanceRequest)
 	if err := dec(in); err != nil {
@@ -257,6 +614,240 @@ func _PaymentService_ViewBalance_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_TopUpBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).TopUpBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_TopUpBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).TopUpBalance(ctx, req.(*TopUpBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_AdjustBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).AdjustBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_AdjustBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).AdjustBalance(ctx, req.(*AdjustBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RefundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RefundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RefundTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RefundTransaction(ctx, req.(*RefundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_IssueGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).IssueGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_IssueGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).IssueGiftCard(ctx, req.(*IssueGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ViewGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ViewGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ViewGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ViewGiftCard(ctx, req.(*ViewGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_RedeemGiftCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemGiftCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).RedeemGiftCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_RedeemGiftCard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).RedeemGiftCard(ctx, req.(*RedeemGiftCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ViewStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ViewStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ViewStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ViewStatement(ctx, req.(*ViewStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListStatements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStatementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListStatements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListStatements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListStatements(ctx, req.(*ListStatementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GenerateStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GenerateStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GenerateStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GenerateStatement(ctx, req.(*GenerateStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_EvaluatePaymentRisk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluatePaymentRiskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).EvaluatePaymentRisk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_EvaluatePaymentRisk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).EvaluatePaymentRisk(ctx, req.(*EvaluatePaymentRiskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_HoldPaymentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldPaymentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).HoldPaymentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_HoldPaymentToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).HoldPaymentToken(ctx, req.(*HoldPaymentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ReleasePaymentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePaymentTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ReleasePaymentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ReleasePaymentToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ReleasePaymentToken(ctx, req.(*ReleasePaymentTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_CheckOrderPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOrderPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).CheckOrderPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_CheckOrderPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).CheckOrderPayment(ctx, req.(*CheckOrderPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PaymentService_ProcessUserCreatedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v11.UserCreatedEvent)
 	if err := dec(in); err != nil {
@@ -311,6 +902,60 @@ func _PaymentService_ProcessShipmentAllocationEvent_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ProcessReturnApprovedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ReturnApprovedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ProcessReturnApprovedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ProcessReturnApprovedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ProcessReturnApprovedEvent(ctx, req.(*v11.ReturnApprovedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ProcessShipmentDispatchedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ShipmentDispatchedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ProcessShipmentDispatchedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ProcessShipmentDispatchedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ProcessShipmentDispatchedEvent(ctx, req.(*v11.ShipmentDispatchedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ProcessOrderRejectedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.OrderRejectedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ProcessOrderRejectedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ProcessOrderRejectedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ProcessOrderRejectedEvent(ctx, req.(*v11.OrderRejectedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -326,10 +971,66 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ViewTransaction",
 			Handler:    _PaymentService_ViewTransaction_Handler,
 		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _PaymentService_ListTransactions_Handler,
+		},
 		{
 			MethodName: "ViewBalance",
 			Handler:    _PaymentService_ViewBalance_Handler,
 		},
+		{
+			MethodName: "TopUpBalance",
+			Handler:    _PaymentService_TopUpBalance_Handler,
+		},
+		{
+			MethodName: "AdjustBalance",
+			Handler:    _PaymentService_AdjustBalance_Handler,
+		},
+		{
+			MethodName: "RefundTransaction",
+			Handler:    _PaymentService_RefundTransaction_Handler,
+		},
+		{
+			MethodName: "IssueGiftCard",
+			Handler:    _PaymentService_IssueGiftCard_Handler,
+		},
+		{
+			MethodName: "ViewGiftCard",
+			Handler:    _PaymentService_ViewGiftCard_Handler,
+		},
+		{
+			MethodName: "RedeemGiftCard",
+			Handler:    _PaymentService_RedeemGiftCard_Handler,
+		},
+		{
+			MethodName: "ViewStatement",
+			Handler:    _PaymentService_ViewStatement_Handler,
+		},
+		{
+			MethodName: "ListStatements",
+			Handler:    _PaymentService_ListStatements_Handler,
+		},
+		{
+			MethodName: "GenerateStatement",
+			Handler:    _PaymentService_GenerateStatement_Handler,
+		},
+		{
+			MethodName: "EvaluatePaymentRisk",
+			Handler:    _PaymentService_EvaluatePaymentRisk_Handler,
+		},
+		{
+			MethodName: "HoldPaymentToken",
+			Handler:    _PaymentService_HoldPaymentToken_Handler,
+		},
+		{
+			MethodName: "ReleasePaymentToken",
+			Handler:    _PaymentService_ReleasePaymentToken_Handler,
+		},
+		{
+			MethodName: "CheckOrderPayment",
+			Handler:    _PaymentService_CheckOrderPayment_Handler,
+		},
 		{
 			MethodName: "ProcessUserCreatedEvent",
 			Handler:    _PaymentService_ProcessUserCreatedEvent_Handler,
@@ -342,6 +1043,18 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessShipmentAllocationEvent",
 			Handler:    _PaymentService_ProcessShipmentAllocationEvent_Handler,
 		},
+		{
+			MethodName: "ProcessReturnApprovedEvent",
+			Handler:    _PaymentService_ProcessReturnApprovedEvent_Handler,
+		},
+		{
+			MethodName: "ProcessShipmentDispatchedEvent",
+			Handler:    _PaymentService_ProcessShipmentDispatchedEvent_Handler,
+		},
+		{
+			MethodName: "ProcessOrderRejectedEvent",
+			Handler:    _PaymentService_ProcessOrderRejectedEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stocklet/payment/v1/service.proto",
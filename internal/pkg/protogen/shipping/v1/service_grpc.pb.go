@@ -37,11 +37,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ShippingService_ServiceInfo_FullMethodName                  = "/stocklet.shipping.v1.ShippingService/ServiceInfo"
-	ShippingService_ViewShipment_FullMethodName                 = "/stocklet.shipping.v1.ShippingService/ViewShipment"
-	ShippingService_ViewShipmentManifest_FullMethodName         = "/stocklet.shipping.v1.ShippingService/ViewShipmentManifest"
-	ShippingService_ProcessStockReservationEvent_FullMethodName = "/stocklet.shipping.v1.ShippingService/ProcessStockReservationEvent"
-	ShippingService_ProcessPaymentProcessedEvent_FullMethodName = "/stocklet.shipping.v1.ShippingService/ProcessPaymentProcessedEvent"
+	ShippingService_ServiceInfo_FullMethodName                   = "/stocklet.shipping.v1.ShippingService/ServiceInfo"
+	ShippingService_ViewShipment_FullMethodName                  = "/stocklet.shipping.v1.ShippingService/ViewShipment"
+	ShippingService_ViewShipmentManifest_FullMethodName          = "/stocklet.shipping.v1.ShippingService/ViewShipmentManifest"
+	ShippingService_DispatchShipment_FullMethodName              = "/stocklet.shipping.v1.ShippingService/DispatchShipment"
+	ShippingService_ProcessStockReservationEvent_FullMethodName  = "/stocklet.shipping.v1.ShippingService/ProcessStockReservationEvent"
+	ShippingService_ProcessPaymentProcessedEvent_FullMethodName  = "/stocklet.shipping.v1.ShippingService/ProcessPaymentProcessedEvent"
+	ShippingService_ProcessTransactionVoidedEvent_FullMethodName = "/stocklet.shipping.v1.ShippingService/ProcessTransactionVoidedEvent"
 )
 
 // ShippingServiceClient is the client API for ShippingService service.
@@ -54,6 +56,10 @@ type ShippingServiceClient interface {
 	ServiceInfo(ctx context.Context, in *v1.ServiceInfoRequest, opts ...grpc.CallOption) (*v1.ServiceInfoResponse, error)
 	ViewShipment(ctx context.Context, in *ViewShipmentRequest, opts ...grpc.CallOption) (*ViewShipmentResponse, error)
 	ViewShipmentManifest(ctx context.Context, in *ViewShipmentManifestRequest, opts ...grpc.CallOption) (*ViewShipmentManifestResponse, error)
+	// Mark a shipment as dispatched (admin only).
+	//
+	// Payment for the order is captured once dispatched.
+	DispatchShipment(ctx context.Context, in *DispatchShipmentRequest, opts ...grpc.CallOption) (*DispatchShipmentResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -66,6 +72,12 @@ type ShippingServiceClient interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessPaymentProcessedEvent(ctx context.Context, in *v11.PaymentProcessedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessTransactionVoidedEvent(ctx context.Context, in *v11.TransactionVoidedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type shippingServiceClient struct {
@@ -103,6 +115,15 @@ func (c *shippingServiceClient) ViewShipmentManifest(ctx context.Context, in *Vi
 	return out, nil
 }
 
+func (c *shippingServiceClient) DispatchShipment(ctx context.Context, in *DispatchShipmentRequest, opts ...grpc.CallOption) (*DispatchShipmentResponse, error) {
+	out := new(DispatchShipmentResponse)
+	err := c.cc.Invoke(ctx, ShippingService_DispatchShipment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *shippingServiceClient) ProcessStockReservationEvent(ctx context.Context, in *v11.StockReservationEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, ShippingService_ProcessStockReservationEvent_FullMethodName, in, out, opts...)
@@ -121,6 +142,15 @@ func (c *shippingServiceClient) ProcessPaymentProcessedEvent(ctx context.Context
 	return out, nil
 }
 
+func (c *shippingServiceClient) ProcessTransactionVoidedEvent(ctx context.Context, in *v11.TransactionVoidedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ShippingService_ProcessTransactionVoidedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ShippingServiceServer is the server API for ShippingService service.
 // All implementations must embed UnimplementedShippingServiceServer
 // for forward compatibility
@@ -131,6 +161,10 @@ type ShippingServiceServer interface {
 	ServiceInfo(context.Context, *v1.ServiceInfoRequest) (*v1.ServiceInfoResponse, error)
 	ViewShipment(context.Context, *ViewShipmentRequest) (*ViewShipmentResponse, error)
 	ViewShipmentManifest(context.Context, *ViewShipmentManifestRequest) (*ViewShipmentManifestResponse, error)
+	// Mark a shipment as dispatched (admin only).
+	//
+	// Payment for the order is captured once dispatched.
+	DispatchShipment(context.Context, *DispatchShipmentRequest) (*DispatchShipmentResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -143,6 +177,12 @@ type ShippingServiceServer interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessPaymentProcessedEvent(context.Context, *v11.PaymentProcessedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessTransactionVoidedEvent(context.Context, *v11.TransactionVoidedEvent) (*emptypb.Empty, error)
 	mustEmbedUnimplementedShippingServiceServer()
 }
 
@@ -159,12 +199,18 @@ func (UnimplementedShippingServiceServer) ViewShipment(context.Context, *ViewShi
 func (UnimplementedShippingServiceServer) ViewShipmentManifest(context.Context, *ViewShipmentManifestRequest) (*ViewShipmentManifestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewShipmentManifest not implemented")
 }
+func (UnimplementedShippingServiceServer) DispatchShipment(context.Context, *DispatchShipmentRequest) (*DispatchShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchShipment not implemented")
+}
 func (UnimplementedShippingServiceServer) ProcessStockReservationEvent(context.Context, *v11.StockReservationEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessStockReservationEvent not implemented")
 }
 func (UnimplementedShippingServiceServer) ProcessPaymentProcessedEvent(context.Context, *v11.PaymentProcessedEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPaymentProcessedEvent not implemented")
 }
+func (UnimplementedShippingServiceServer) ProcessTransactionVoidedEvent(context.Context, *v11.TransactionVoidedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessTransactionVoidedEvent not implemented")
+}
 func (UnimplementedShippingServiceServer) mustEmbedUnimplementedShippingServiceServer() {}
 
 // UnsafeShippingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -232,6 +278,24 @@ func _ShippingService_ViewShipmentManifest_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShippingService_DispatchShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DispatchShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).DispatchShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_DispatchShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).DispatchShipment(ctx, req.(*DispatchShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ShippingService_ProcessStockReservationEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v11.StockReservationEvent)
 	if err := dec(in); err != nil {
@@ -268,6 +332,24 @@ func _ShippingService_ProcessPaymentProcessedEvent_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ShippingService_ProcessTransactionVoidedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.TransactionVoidedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ShippingServiceServer).ProcessTransactionVoidedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ShippingService_ProcessTransactionVoidedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ShippingServiceServer).ProcessTransactionVoidedEvent(ctx, req.(*v11.TransactionVoidedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ShippingService_ServiceDesc is the grpc.ServiceDesc for ShippingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -287,6 +369,10 @@ var ShippingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ViewShipmentManifest",
 			Handler:    _ShippingService_ViewShipmentManifest_Handler,
 		},
+		{
+			MethodName: "DispatchShipment",
+			Handler:    _ShippingService_DispatchShipment_Handler,
+		},
 		{
 			MethodName: "ProcessStockReservationEvent",
 			Handler:    _ShippingService_ProcessStockReservationEvent_Handler,
@@ -295,6 +381,10 @@ var ShippingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPaymentProcessedEvent",
 			Handler:    _ShippingService_ProcessPaymentProcessedEvent_Handler,
 		},
+		{
+			MethodName: "ProcessTransactionVoidedEvent",
+			Handler:    _ShippingService_ProcessTransactionVoidedEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stocklet/shipping/v1/service.proto",
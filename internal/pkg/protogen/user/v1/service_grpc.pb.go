@@ -35,9 +35,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	UserService_ServiceInfo_FullMethodName  = "/stocklet.user.v1.UserService/ServiceInfo"
-	UserService_ViewUser_FullMethodName     = "/stocklet.user.v1.UserService/ViewUser"
-	UserService_RegisterUser_FullMethodName = "/stocklet.user.v1.UserService/RegisterUser"
+	UserService_ServiceInfo_FullMethodName             = "/stocklet.user.v1.UserService/ServiceInfo"
+	UserService_ViewUser_FullMethodName                = "/stocklet.user.v1.UserService/ViewUser"
+	UserService_RegisterUser_FullMethodName            = "/stocklet.user.v1.UserService/RegisterUser"
+	UserService_UpdatePreferredCurrency_FullMethodName = "/stocklet.user.v1.UserService/UpdatePreferredCurrency"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -50,6 +51,7 @@ type UserServiceClient interface {
 	ServiceInfo(ctx context.Context, in *v1.ServiceInfoRequest, opts ...grpc.CallOption) (*v1.ServiceInfoResponse, error)
 	ViewUser(ctx context.Context, in *ViewUserRequest, opts ...grpc.CallOption) (*ViewUserResponse, error)
 	RegisterUser(ctx context.Context, in *RegisterUserRequest, opts ...grpc.CallOption) (*RegisterUserResponse, error)
+	UpdatePreferredCurrency(ctx context.Context, in *UpdatePreferredCurrencyRequest, opts ...grpc.CallOption) (*UpdatePreferredCurrencyResponse, error)
 }
 
 type userServiceClient struct {
@@ -87,6 +89,15 @@ func (c *userServiceClient) RegisterUser(ctx context.Context, in *RegisterUserRe
 	return out, nil
 }
 
+func (c *userServiceClient) UpdatePreferredCurrency(ctx context.Context, in *UpdatePreferredCurrencyRequest, opts ...grpc.CallOption) (*UpdatePreferredCurrencyResponse, error) {
+	out := new(UpdatePreferredCurrencyResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdatePreferredCurrency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -97,6 +108,7 @@ type UserServiceServer interface {
 	ServiceInfo(context.Context, *v1.ServiceInfoRequest) (*v1.ServiceInfoResponse, error)
 	ViewUser(context.Context, *ViewUserRequest) (*ViewUserResponse, error)
 	RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error)
+	UpdatePreferredCurrency(context.Context, *UpdatePreferredCurrencyRequest) (*UpdatePreferredCurrencyResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -113,6 +125,9 @@ func (UnimplementedUserServiceServer) ViewUser(context.Context, *ViewUserRequest
 func (UnimplementedUserServiceServer) RegisterUser(context.Context, *RegisterUserRequest) (*RegisterUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterUser not implemented")
 }
+func (UnimplementedUserServiceServer) UpdatePreferredCurrency(context.Context, *UpdatePreferredCurrencyRequest) (*UpdatePreferredCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferredCurrency not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -180,6 +195,24 @@ func _UserService_RegisterUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UpdatePreferredCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePreferredCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePreferredCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePreferredCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePreferredCurrency(ctx, req.(*UpdatePreferredCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -199,6 +232,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterUser",
 			Handler:    _UserService_RegisterUser_Handler,
 		},
+		{
+			MethodName: "UpdatePreferredCurrency",
+			Handler:    _UserService_UpdatePreferredCurrency_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stocklet/user/v1/service.proto",
@@ -37,13 +37,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	WarehouseService_ServiceInfo_FullMethodName                    = "/stocklet.warehouse.v1.WarehouseService/ServiceInfo"
-	WarehouseService_ViewProductStock_FullMethodName               = "/stocklet.warehouse.v1.WarehouseService/ViewProductStock"
-	WarehouseService_ViewReservation_FullMethodName                = "/stocklet.warehouse.v1.WarehouseService/ViewReservation"
-	WarehouseService_ProcessProductCreatedEvent_FullMethodName     = "/stocklet.warehouse.v1.WarehouseService/ProcessProductCreatedEvent"
-	WarehouseService_ProcessOrderPendingEvent_FullMethodName       = "/stocklet.warehouse.v1.WarehouseService/ProcessOrderPendingEvent"
-	WarehouseService_ProcessShipmentAllocationEvent_FullMethodName = "/stocklet.warehouse.v1.WarehouseService/ProcessShipmentAllocationEvent"
-	WarehouseService_ProcessPaymentProcessedEvent_FullMethodName   = "/stocklet.warehouse.v1.WarehouseService/ProcessPaymentProcessedEvent"
+	WarehouseService_ServiceInfo_FullMethodName                       = "/stocklet.warehouse.v1.WarehouseService/ServiceInfo"
+	WarehouseService_ViewProductStock_FullMethodName                  = "/stocklet.warehouse.v1.WarehouseService/ViewProductStock"
+	WarehouseService_ViewReservation_FullMethodName                   = "/stocklet.warehouse.v1.WarehouseService/ViewReservation"
+	WarehouseService_AddProductStock_FullMethodName                   = "/stocklet.warehouse.v1.WarehouseService/AddProductStock"
+	WarehouseService_ProcessProductVariantCreatedEvent_FullMethodName = "/stocklet.warehouse.v1.WarehouseService/ProcessProductVariantCreatedEvent"
+	WarehouseService_ProcessOrderPendingEvent_FullMethodName          = "/stocklet.warehouse.v1.WarehouseService/ProcessOrderPendingEvent"
+	WarehouseService_ProcessShipmentAllocationEvent_FullMethodName    = "/stocklet.warehouse.v1.WarehouseService/ProcessShipmentAllocationEvent"
+	WarehouseService_ProcessPaymentProcessedEvent_FullMethodName      = "/stocklet.warehouse.v1.WarehouseService/ProcessPaymentProcessedEvent"
+	WarehouseService_ProcessReturnApprovedEvent_FullMethodName        = "/stocklet.warehouse.v1.WarehouseService/ProcessReturnApprovedEvent"
 )
 
 // WarehouseServiceClient is the client API for WarehouseService service.
@@ -54,14 +56,19 @@ type WarehouseServiceClient interface {
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ServiceInfo(ctx context.Context, in *v1.ServiceInfoRequest, opts ...grpc.CallOption) (*v1.ServiceInfoResponse, error)
+	// View the stock of a product variant.
 	ViewProductStock(ctx context.Context, in *ViewProductStockRequest, opts ...grpc.CallOption) (*ViewProductStockResponse, error)
 	ViewReservation(ctx context.Context, in *ViewReservationRequest, opts ...grpc.CallOption) (*ViewReservationResponse, error)
+	// Add stock for a product variant.
+	//
+	// Any backorders for the variant will be filled from the added stock.
+	AddProductStock(ctx context.Context, in *AddProductStockRequest, opts ...grpc.CallOption) (*AddProductStockResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
-	ProcessProductCreatedEvent(ctx context.Context, in *v11.ProductCreatedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ProcessProductVariantCreatedEvent(ctx context.Context, in *v11.ProductVariantCreatedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -80,6 +87,12 @@ type WarehouseServiceClient interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessPaymentProcessedEvent(ctx context.Context, in *v11.PaymentProcessedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessReturnApprovedEvent(ctx context.Context, in *v11.ReturnApprovedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type warehouseServiceClient struct {
@@ -117,9 +130,18 @@ func (c *warehouseServiceClient) ViewReservation(ctx context.Context, in *ViewRe
 	return out, nil
 }
 
-func (c *warehouseServiceClient) ProcessProductCreatedEvent(ctx context.Context, in *v11.ProductCreatedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *warehouseServiceClient) AddProductStock(ctx context.Context, in *AddProductStockRequest, opts ...grpc.CallOption) (*AddProductStockResponse, error) {
+	out := new(AddProductStockResponse)
+	err := c.cc.Invoke(ctx, WarehouseService_AddProductStock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) ProcessProductVariantCreatedEvent(ctx context.Context, in *v11.ProductVariantCreatedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, WarehouseService_ProcessProductCreatedEvent_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, WarehouseService_ProcessProductVariantCreatedEvent_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -153,6 +175,15 @@ func (c *warehouseServiceClient) ProcessPaymentProcessedEvent(ctx context.Contex
 	return out, nil
 }
 
+func (c *warehouseServiceClient) ProcessReturnApprovedEvent(ctx context.Context, in *v11.ReturnApprovedEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_ProcessReturnApprovedEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WarehouseServiceServer is the server API for WarehouseService service.
 // All implementations must embed UnimplementedWarehouseServiceServer
 // for forward compatibility
@@ -161,14 +192,19 @@ type WarehouseServiceServer interface {
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	ServiceInfo(context.Context, *v1.ServiceInfoRequest) (*v1.ServiceInfoResponse, error)
+	// View the stock of a product variant.
 	ViewProductStock(context.Context, *ViewProductStockRequest) (*ViewProductStockResponse, error)
 	ViewReservation(context.Context, *ViewReservationRequest) (*ViewReservationResponse, error)
+	// Add stock for a product variant.
+	//
+	// Any backorders for the variant will be filled from the added stock.
+	AddProductStock(context.Context, *AddProductStockRequest) (*AddProductStockResponse, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
-	ProcessProductCreatedEvent(context.Context, *v11.ProductCreatedEvent) (*emptypb.Empty, error)
+	ProcessProductVariantCreatedEvent(context.Context, *v11.ProductVariantCreatedEvent) (*emptypb.Empty, error)
 	// A consumer will call this method to process events.
 	//
 	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
@@ -187,6 +223,12 @@ type WarehouseServiceServer interface {
 	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
 	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
 	ProcessPaymentProcessedEvent(context.Context, *v11.PaymentProcessedEvent) (*emptypb.Empty, error)
+	// A consumer will call this method to process events.
+	//
+	// buf:lint:ignore RPC_REQUEST_RESPONSE_UNIQUE
+	// buf:lint:ignore RPC_REQUEST_STANDARD_NAME
+	// buf:lint:ignore RPC_RESPONSE_STANDARD_NAME
+	ProcessReturnApprovedEvent(context.Context, *v11.ReturnApprovedEvent) (*emptypb.Empty, error)
 	mustEmbedUnimplementedWarehouseServiceServer()
 }
 
@@ -203,8 +245,11 @@ func (UnimplementedWarehouseServiceServer) ViewProductStock(context.Context, *Vi
 func (UnimplementedWarehouseServiceServer) ViewReservation(context.Context, *ViewReservationRequest) (*ViewReservationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ViewReservation not implemented")
 }
-func (UnimplementedWarehouseServiceServer) ProcessProductCreatedEvent(context.Context, *v11.ProductCreatedEvent) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessProductCreatedEvent not implemented")
+func (UnimplementedWarehouseServiceServer) AddProductStock(context.Context, *AddProductStockRequest) (*AddProductStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductStock not implemented")
+}
+func (UnimplementedWarehouseServiceServer) ProcessProductVariantCreatedEvent(context.Context, *v11.ProductVariantCreatedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessProductVariantCreatedEvent not implemented")
 }
 func (UnimplementedWarehouseServiceServer) ProcessOrderPendingEvent(context.Context, *v11.OrderPendingEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessOrderPendingEvent not implemented")
@@ -215,6 +260,9 @@ func (UnimplementedWarehouseServiceServer) ProcessShipmentAllocationEvent(contex
 func (UnimplementedWarehouseServiceServer) ProcessPaymentProcessedEvent(context.Context, *v11.PaymentProcessedEvent) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProcessPaymentProcessedEvent not implemented")
 }
+func (UnimplementedWarehouseServiceServer) ProcessReturnApprovedEvent(context.Context, *v11.ReturnApprovedEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessReturnApprovedEvent not implemented")
+}
 func (UnimplementedWarehouseServiceServer) mustEmbedUnimplementedWarehouseServiceServer() {}
 
 // UnsafeWarehouseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -282,20 +330,38 @@ func _WarehouseService_ViewReservation_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WarehouseService_ProcessProductCreatedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v11.ProductCreatedEvent)
+func _WarehouseService_AddProductStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductStockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WarehouseServiceServer).ProcessProductCreatedEvent(ctx, in)
+		return srv.(WarehouseServiceServer).AddProductStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WarehouseService_ProcessProductCreatedEvent_FullMethodName,
+		FullMethod: WarehouseService_AddProductStock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WarehouseServiceServer).ProcessProductCreatedEvent(ctx, req.(*v11.ProductCreatedEvent))
+		return srv.(WarehouseServiceServer).AddProductStock(ctx, req.(*AddProductStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_ProcessProductVariantCreatedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ProductVariantCreatedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).ProcessProductVariantCreatedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_ProcessProductVariantCreatedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).ProcessProductVariantCreatedEvent(ctx, req.(*v11.ProductVariantCreatedEvent))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -354,6 +420,24 @@ func _WarehouseService_ProcessPaymentProcessedEvent_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WarehouseService_ProcessReturnApprovedEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v11.ReturnApprovedEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).ProcessReturnApprovedEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_ProcessReturnApprovedEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).ProcessReturnApprovedEvent(ctx, req.(*v11.ReturnApprovedEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WarehouseService_ServiceDesc is the grpc.ServiceDesc for WarehouseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -374,8 +458,12 @@ var WarehouseService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _WarehouseService_ViewReservation_Handler,
 		},
 		{
-			MethodName: "ProcessProductCreatedEvent",
-			Handler:    _WarehouseService_ProcessProductCreatedEvent_Handler,
+			MethodName: "AddProductStock",
+			Handler:    _WarehouseService_AddProductStock_Handler,
+		},
+		{
+			MethodName: "ProcessProductVariantCreatedEvent",
+			Handler:    _WarehouseService_ProcessProductVariantCreatedEvent_Handler,
 		},
 		{
 			MethodName: "ProcessOrderPendingEvent",
@@ -389,6 +477,10 @@ var WarehouseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProcessPaymentProcessedEvent",
 			Handler:    _WarehouseService_ProcessPaymentProcessedEvent_Handler,
 		},
+		{
+			MethodName: "ProcessReturnApprovedEvent",
+			Handler:    _WarehouseService_ProcessReturnApprovedEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "stocklet/warehouse/v1/service.proto",
@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	"github.com/hexolan/stocklet/internal/pkg/config"
 	"github.com/hexolan/stocklet/internal/pkg/gwauth"
@@ -56,6 +57,9 @@ func withGatewayHeaderOpt() runtime.ServeMuxOption {
 				// Envoy will validate JWT tokens and provide a payload header
 				// containing a base64 string of the token claims.
 				return "jwt-payload", true
+			case gwauth.IdempotencyKeyHeader:
+				// Clients may provide an idempotency key to safely retry requests.
+				return "idempotency-key", true
 			default:
 				return key, false
 			}
@@ -63,6 +67,42 @@ func withGatewayHeaderOpt() runtime.ServeMuxOption {
 	)
 }
 
+// Marshaler used to stream responses as Server-Sent Events.
+//
+// Used when clients request a 'text/event-stream' response.
+type eventStreamMarshaler struct {
+	runtime.JSONPb
+}
+
+func (m *eventStreamMarshaler) ContentType(_ interface{}) string {
+	return "text/event-stream"
+}
+
+func (m *eventStreamMarshaler) Marshal(v interface{}) ([]byte, error) {
+	data, err := m.JSONPb.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte("data: "), data...), nil
+}
+
+func (m *eventStreamMarshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+func withGatewayEventStreamOpt() runtime.ServeMuxOption {
+	return runtime.WithMarshalerOption(
+		"text/event-stream",
+		&eventStreamMarshaler{
+			JSONPb: runtime.JSONPb{
+				MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+				UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+			},
+		},
+	)
+}
+
 func withGatewayLogger(h http.Handler) http.Handler {
 	return http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
@@ -78,6 +118,7 @@ func NewGatewayServeBase(cfg *config.SharedConfig) (*runtime.ServeMux, []grpc.Di
 		withGatewayErrorHandler(),
 		withGatewayMetadataOpt(),
 		withGatewayHeaderOpt(),
+		withGatewayEventStreamOpt(),
 	)
 
 	// Attach open telemetry instrumentation through the gRPC client options
@@ -45,6 +45,7 @@ type AuthService struct {
 type StorageController interface {
 	SetPassword(ctx context.Context, userId string, password string) error
 	VerifyPassword(ctx context.Context, userId string, password string) (bool, error)
+	GetRole(ctx context.Context, userId string) (string, error)
 
 	DeleteAuthMethods(ctx context.Context, userId string) error
 }
@@ -95,8 +96,14 @@ func (svc AuthService) LoginPassword(ctx context.Context, req *pb.LoginPasswordR
 		return nil, errors.WrapServiceError(errors.ErrCodeForbidden, "invalid user id or password", err)
 	}
 
+	// Get the role of the user
+	role, err := svc.store.GetRole(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
 	// Issue token for the user
-	token, err := issueToken(svc.cfg, req.UserId)
+	token, err := issueToken(svc.cfg, req.UserId, role)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeService, "error issuing token", err)
 	}
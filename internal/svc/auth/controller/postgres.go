@@ -18,9 +18,11 @@ package controller
 import (
 	"context"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
 	"github.com/hexolan/stocklet/internal/svc/auth"
 )
 
@@ -87,6 +89,23 @@ func (c postgresController) VerifyPassword(ctx context.Context, userId string, p
 	return true, nil
 }
 
+// Get the role granted to a user.
+//
+// Users without an assigned role are customers.
+func (c postgresController) GetRole(ctx context.Context, userId string) (string, error) {
+	var role pgtype.Text
+	err := c.cl.QueryRow(ctx, "SELECT role FROM auth_methods WHERE user_id=$1", userId).Scan(&role)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeNotFound, "unknown user id", err)
+	}
+
+	if !role.Valid {
+		return gwauth.RoleCustomer, nil
+	}
+
+	return role.String, nil
+}
+
 func (c postgresController) DeleteAuthMethods(ctx context.Context, userId string) error {
 	_, err := c.cl.Exec(ctx, "DELETE FROM auth_methods WHERE user_id=$1", userId)
 	if err != nil {
@@ -27,18 +27,24 @@ import (
 )
 
 // Issues a JWT token
-func issueToken(cfg *ServiceConfig, sub string) (*pb.AuthToken, error) {
+//
+// The role claim is only included for users with elevated roles.
+func issueToken(cfg *ServiceConfig, sub string, role string) (*pb.AuthToken, error) {
 	const expiryTime = 86400 // 1 day
 
 	// Set token claims
 	token := jwt.New()
 	claims := &gwauth.JWTClaims{
 		Subject:  sub,
+		Role:     role,
 		IssuedAt: time.Now().Unix(),
 		Expiry:   time.Now().Unix() + expiryTime,
 	}
 
 	token.Set("sub", claims.Subject)
+	if claims.Role != "" && claims.Role != gwauth.RoleCustomer {
+		token.Set("role", claims.Role)
+	}
 	token.Set("iat", claims.IssuedAt)
 	token.Set("exp", claims.Expiry)
 
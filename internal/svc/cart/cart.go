@@ -0,0 +1,232 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package cart
+
+import (
+	"context"
+
+	"github.com/bufbuild/protovalidate-go"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
+	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/cart/v1"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
+	orderpb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
+)
+
+// Interface for the service
+type CartService struct {
+	pb.UnimplementedCartServiceServer
+
+	store StorageController
+	pbVal *protovalidate.Validator
+}
+
+// Interface for database methods
+// Flexibility for implementing separate controllers for different databases (e.g. Postgres, MongoDB, etc)
+type StorageController interface {
+	GetCart(ctx context.Context, customerId string) (*pb.Cart, error)
+
+	AddCartItem(ctx context.Context, customerId string, variantId string, quantity int32) (*pb.Cart, error)
+	UpdateCartItemQuantity(ctx context.Context, customerId string, variantId string, quantity int32) (*pb.Cart, error)
+	RemoveCartItem(ctx context.Context, customerId string, variantId string) (*pb.Cart, error)
+
+	CheckoutCart(ctx context.Context, customerId string, idempotencyKey *string, fulfilmentMode orderpb.FulfilmentMode, paymentMethod commonpb.PaymentMethod, paymentToken *string) (*orderpb.Order, error)
+	ClearCheckedOutCart(ctx context.Context, orderId string) error
+}
+
+// Interface for event consumption
+// Flexibility for separate controllers for different messaging systems (e.g. Kafka, NATS, etc)
+type ConsumerController interface {
+	messaging.ConsumerController
+
+	Attach(svc pb.CartServiceServer)
+}
+
+// Create the cart service
+func NewCartService(cfg *ServiceConfig, store StorageController) *CartService {
+	// Initialise the protobuf validator
+	pbVal, err := protovalidate.New()
+	if err != nil {
+		log.Panic().Err(err).Msg("failed to initialise protobuf validator")
+	}
+
+	// Initialise the service
+	return &CartService{
+		store: store,
+		pbVal: pbVal,
+	}
+}
+
+func (svc CartService) ServiceInfo(ctx context.Context, req *commonpb.ServiceInfoRequest) (*commonpb.ServiceInfoResponse, error) {
+	return &commonpb.ServiceInfoResponse{
+		Name:          "cart",
+		Source:        "https://github.com/hexolan/stocklet",
+		SourceLicense: "AGPL-3.0",
+	}, nil
+}
+
+// If the request is through the gateway, then the customer id is substituted for the current user.
+func getRequestCustomerId(ctx context.Context, customerId string) (string, error) {
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		// ensure user is authenticated
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return "", err
+		}
+
+		return claims.Subject, nil
+	}
+
+	return customerId, nil
+}
+
+func (svc CartService) ViewCart(ctx context.Context, req *pb.ViewCartRequest) (*pb.ViewCartResponse, error) {
+	// Substitute the customer id (for gateway requests)
+	customerId, err := getRequestCustomerId(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	req.CustomerId = customerId
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Get the priced cart
+	cart, err := svc.store.GetCart(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewCartResponse{Cart: cart}, nil
+}
+
+func (svc CartService) AddCartItem(ctx context.Context, req *pb.AddCartItemRequest) (*pb.AddCartItemResponse, error) {
+	// Substitute the customer id (for gateway requests)
+	customerId, err := getRequestCustomerId(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	req.CustomerId = customerId
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Add the item to the cart
+	cart, err := svc.store.AddCartItem(ctx, req.CustomerId, req.VariantId, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddCartItemResponse{Cart: cart}, nil
+}
+
+func (svc CartService) UpdateCartItemQuantity(ctx context.Context, req *pb.UpdateCartItemQuantityRequest) (*pb.UpdateCartItemQuantityResponse, error) {
+	// Substitute the customer id (for gateway requests)
+	customerId, err := getRequestCustomerId(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	req.CustomerId = customerId
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Update the item quantity
+	cart, err := svc.store.UpdateCartItemQuantity(ctx, req.CustomerId, req.VariantId, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCartItemQuantityResponse{Cart: cart}, nil
+}
+
+func (svc CartService) RemoveCartItem(ctx context.Context, req *pb.RemoveCartItemRequest) (*pb.RemoveCartItemResponse, error) {
+	// Substitute the customer id (for gateway requests)
+	customerId, err := getRequestCustomerId(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	req.CustomerId = customerId
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Remove the item from the cart
+	cart, err := svc.store.RemoveCartItem(ctx, req.CustomerId, req.VariantId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveCartItemResponse{Cart: cart}, nil
+}
+
+func (svc CartService) Checkout(ctx context.Context, req *pb.CheckoutRequest) (*pb.CheckoutResponse, error) {
+	// Substitute the customer id (for gateway requests)
+	customerId, err := getRequestCustomerId(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	req.CustomerId = customerId
+
+	// use the idempotency key from the request headers (if not provided in the body)
+	if gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx); gatewayRequest && req.IdempotencyKey == nil {
+		req.IdempotencyKey = gwauth.GetGatewayIdempotencyKey(gwMd)
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Place an order for the cart.
+	//
+	// The cart is kept until the order has been approved,
+	// so the items are not lost if the order is rejected.
+	order, err := svc.store.CheckoutCart(ctx, req.CustomerId, req.IdempotencyKey, req.FulfilmentMode, req.PaymentMethod, req.PaymentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckoutResponse{Order: order}, nil
+}
+
+func (svc CartService) ProcessOrderApprovedEvent(ctx context.Context, req *eventpb.OrderApprovedEvent) (*emptypb.Empty, error) {
+	err := svc.store.ClearCheckedOutCart(ctx, req.OrderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
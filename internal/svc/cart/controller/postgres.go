@@ -0,0 +1,473 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/cart/v1"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	orderpb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
+	productpb "github.com/hexolan/stocklet/internal/pkg/protogen/product/v1"
+	"github.com/hexolan/stocklet/internal/svc/cart"
+)
+
+const pgCartItemBaseQuery string = "SELECT variant_id, quantity, created_at, updated_at FROM cart_items"
+
+type postgresController struct {
+	cl          *pgxpool.Pool
+	serviceOpts *cart.ServiceConfigOpts
+}
+
+func NewPostgresController(cl *pgxpool.Pool, serviceOpts *cart.ServiceConfigOpts) cart.StorageController {
+	return postgresController{cl: cl, serviceOpts: serviceOpts}
+}
+
+// Get a customer's cart, priced with the current product prices.
+func (c postgresController) GetCart(ctx context.Context, customerId string) (*pb.Cart, error) {
+	items, err := c.getCartItems(ctx, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.priceCart(ctx, customerId, items)
+}
+
+func (c postgresController) getCartItems(ctx context.Context, customerId string) ([]*pb.CartItem, error) {
+	rows, err := c.cl.Query(ctx, pgCartItemBaseQuery+" WHERE customer_id=$1 ORDER BY created_at", customerId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "query error whilst fetching cart items", err)
+	}
+
+	items := []*pb.CartItem{}
+	for rows.Next() {
+		item, err := scanRowToCartItem(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, item)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error whilst scanning cart item rows", rows.Err())
+	}
+
+	return items, nil
+}
+
+// Price the cart items using the product service.
+//
+// Variants that are no longer available are left unpriced.
+func (c postgresController) priceCart(ctx context.Context, customerId string, items []*pb.CartItem) (*pb.Cart, error) {
+	cartObj := &pb.Cart{CustomerId: customerId, Items: items}
+	if len(items) == 0 {
+		return cartObj, nil
+	}
+
+	// Establish connection with product service
+	productConn, err := c.dialService(c.serviceOpts.ProductServiceGrpc)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to establish connection to product service", err)
+	}
+	defer productConn.Close()
+	productCl := productpb.NewProductServiceClient(productConn)
+
+	productCtx, productCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer productCtxCancel()
+
+	cartObj.ItemsPrice = money.New(0, money.DefaultCurrency)
+	for _, item := range items {
+		variant, err := getProductVariant(productCtx, productCl, item.VariantId)
+		if err != nil {
+			return nil, err
+		} else if variant == nil {
+			continue
+		}
+
+		item.ProductId = variant.ProductId
+		item.Sku = variant.Sku
+		item.UnitPrice = variant.Price
+		item.LineTotal = money.New(variant.Price.GetMinorUnits()*int64(item.Quantity), variant.Price.GetCurrencyCode())
+		cartObj.ItemsPrice.MinorUnits += item.LineTotal.MinorUnits
+		cartObj.ItemsPrice.CurrencyCode = item.LineTotal.CurrencyCode
+	}
+
+	return cartObj, nil
+}
+
+func (c postgresController) AddCartItem(ctx context.Context, customerId string, variantId string, quantity int32) (*pb.Cart, error) {
+	// Establish connection with product service
+	productConn, err := c.dialService(c.serviceOpts.ProductServiceGrpc)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to establish connection to product service", err)
+	}
+	defer productConn.Close()
+	productCl := productpb.NewProductServiceClient(productConn)
+
+	// Ensure the product variant exists
+	productCtx, productCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer productCtxCancel()
+	variant, err := getProductVariant(productCtx, productCl, variantId)
+	if err != nil {
+		return nil, err
+	} else if variant == nil {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "product variant not found")
+	}
+
+	// Add the item (or increase the quantity of an existing item)
+	_, err = c.cl.Exec(
+		ctx,
+		`INSERT INTO cart_items (customer_id, variant_id, quantity) VALUES ($1, $2, $3)
+		ON CONFLICT (customer_id, variant_id) DO UPDATE SET quantity=cart_items.quantity + EXCLUDED.quantity, updated_at=timezone('utc', now())`,
+		customerId,
+		variantId,
+		quantity,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to add cart item", err)
+	}
+
+	return c.GetCart(ctx, customerId)
+}
+
+func (c postgresController) UpdateCartItemQuantity(ctx context.Context, customerId string, variantId string, quantity int32) (*pb.Cart, error) {
+	result, err := c.cl.Exec(
+		ctx,
+		"UPDATE cart_items SET quantity=$1, updated_at=timezone('utc', now()) WHERE customer_id=$2 AND variant_id=$3",
+		quantity,
+		customerId,
+		variantId,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update cart item", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "item not in cart")
+	}
+
+	return c.GetCart(ctx, customerId)
+}
+
+func (c postgresController) RemoveCartItem(ctx context.Context, customerId string, variantId string) (*pb.Cart, error) {
+	result, err := c.cl.Exec(ctx, "DELETE FROM cart_items WHERE customer_id=$1 AND variant_id=$2", customerId, variantId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to remove cart item", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "item not in cart")
+	}
+
+	return c.GetCart(ctx, customerId)
+}
+
+// Place an order for the items in a customer's cart.
+//
+// The id of the checkout is used as the idempotency key of the order, so
+// that retried checkouts do not place duplicate orders. The checkout is
+// recorded, so that the cart can be cleared once the order is approved.
+func (c postgresController) CheckoutCart(ctx context.Context, customerId string, idempotencyKey *string, fulfilmentMode orderpb.FulfilmentMode, paymentMethod commonpb.PaymentMethod, paymentToken *string) (*orderpb.Order, error) {
+	items, err := c.getCartItems(ctx, customerId)
+	if err != nil {
+		return nil, err
+	} else if len(items) == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "cart is empty")
+	}
+
+	orderCart := make(map[string]int32)
+	for _, item := range items {
+		orderCart[item.VariantId] = item.Quantity
+	}
+
+	// Open a checkout for the cart (or resume the pending checkout)
+	checkoutId, err := c.openCheckout(ctx, customerId, idempotencyKey, hashCart(orderCart))
+	if err != nil {
+		return nil, err
+	}
+	checkoutKey := "checkout:" + checkoutId
+
+	// Establish connection with order service
+	orderConn, err := c.dialService(c.serviceOpts.OrderServiceGrpc)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to establish connection to order service", err)
+	}
+	defer orderConn.Close()
+	orderCl := orderpb.NewOrderServiceClient(orderConn)
+
+	// Place the order
+	orderCtx, orderCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer orderCtxCancel()
+	resp, err := orderCl.PlaceOrder(
+		orderCtx,
+		&orderpb.PlaceOrderRequest{
+			Cart:           orderCart,
+			CustomerId:     customerId,
+			IdempotencyKey: &checkoutKey,
+			FulfilmentMode: fulfilmentMode,
+			PaymentMethod:  paymentMethod,
+			PaymentToken:   paymentToken,
+		},
+	)
+	if err != nil {
+		// Provide invalid argument errors (e.g. idempotency key reuse) to the user.
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return nil, errors.WrapServiceError(errors.ErrCodeInvalidArgument, st.Message(), err)
+		}
+
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to place order", err)
+	}
+
+	// Record the checkout (and the items that were ordered)
+	//
+	// Retried checkouts will return the same order.
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(
+		ctx,
+		"INSERT INTO cart_checkouts (order_id, customer_id) VALUES ($1, $2) ON CONFLICT (order_id) DO NOTHING",
+		resp.Order.Id,
+		customerId,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to record checkout", err)
+	}
+
+	if result.RowsAffected() != 0 {
+		for variantId, quantity := range orderCart {
+			_, err = tx.Exec(
+				ctx,
+				"INSERT INTO cart_checkout_items (order_id, variant_id, quantity) VALUES ($1, $2, $3)",
+				resp.Order.Id,
+				variantId,
+				quantity,
+			)
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to record checkout item", err)
+			}
+		}
+	}
+
+	// Close the pending checkout
+	//
+	// Checkouts opened with a client provided key are kept, so
+	// that retries with the key continue to return the order.
+	_, err = tx.Exec(ctx, "DELETE FROM cart_pending_checkouts WHERE id=$1 AND idempotency_key=''", checkoutId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to close checkout", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return resp.Order, nil
+}
+
+// Open a checkout for a customer's cart, returning the id of the checkout.
+//
+// The pending checkout is resumed when retried with the same idempotency key,
+// or (without a key) when retried for the same cart before the order was recorded.
+func (c postgresController) openCheckout(ctx context.Context, customerId string, idempotencyKey *string, cartHash string) (string, error) {
+	key := ""
+	if idempotencyKey != nil {
+		key = *idempotencyKey
+	} else {
+		// Discard any pending checkout for the cart before it was changed
+		_, err := c.cl.Exec(ctx, "DELETE FROM cart_pending_checkouts WHERE customer_id=$1 AND idempotency_key='' AND cart_hash<>$2", customerId, cartHash)
+		if err != nil {
+			return "", errors.WrapServiceError(errors.ErrCodeExtService, "failed to open checkout", err)
+		}
+	}
+
+	_, err := c.cl.Exec(
+		ctx,
+		"INSERT INTO cart_pending_checkouts (customer_id, idempotency_key, cart_hash) VALUES ($1, $2, $3) ON CONFLICT (customer_id, idempotency_key) DO NOTHING",
+		customerId,
+		key,
+		cartHash,
+	)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeExtService, "failed to open checkout", err)
+	}
+
+	var checkoutId string
+	err = c.cl.QueryRow(ctx, "SELECT id FROM cart_pending_checkouts WHERE customer_id=$1 AND idempotency_key=$2", customerId, key).Scan(&checkoutId)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeExtService, "failed to open checkout", err)
+	}
+
+	return checkoutId, nil
+}
+
+// Hash the contents of a cart (Variant ID to Quantity).
+func hashCart(items map[string]int32) string {
+	entries := make([]string, 0, len(items))
+	for variantId, quantity := range items {
+		entries = append(entries, fmt.Sprintf("%s:%d", variantId, quantity))
+	}
+	sort.Strings(entries)
+
+	hash := sha256.Sum256([]byte(strings.Join(entries, ",")))
+	return hex.EncodeToString(hash[:])
+}
+
+// Clear the cart of the customer who placed an order (through checkout).
+func (c postgresController) ClearCheckedOutCart(ctx context.Context, orderId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Get the checkout record
+	var customerId string
+	err = tx.QueryRow(ctx, "SELECT customer_id FROM cart_checkouts WHERE order_id=$1 FOR UPDATE", orderId).Scan(&customerId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// The order was not placed through checkout
+			return nil
+		}
+
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch checkout", err)
+	}
+
+	// Remove the ordered items from the cart
+	//
+	// Items added to the cart after checkout (or additional quantities
+	// of the ordered items) are kept in the cart.
+	_, err = tx.Exec(
+		ctx,
+		`DELETE FROM cart_items ci
+		USING cart_checkout_items co
+		WHERE co.order_id=$1 AND ci.customer_id=$2 AND ci.variant_id=co.variant_id AND ci.quantity <= co.quantity`,
+		orderId,
+		customerId,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to clear cart", err)
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE cart_items ci SET quantity=ci.quantity - co.quantity, updated_at=timezone('utc', now())
+		FROM cart_checkout_items co
+		WHERE co.order_id=$1 AND ci.customer_id=$2 AND ci.variant_id=co.variant_id AND ci.quantity > co.quantity`,
+		orderId,
+		customerId,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to clear cart", err)
+	}
+
+	// Remove the checkout record
+	_, err = tx.Exec(ctx, "DELETE FROM cart_checkouts WHERE order_id=$1", orderId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to remove checkout", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+func (c postgresController) dialService(addr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+}
+
+// Get a product variant from the product service.
+//
+// Returns nil if the variant does not exist.
+func getProductVariant(ctx context.Context, productCl productpb.ProductServiceClient, variantId string) (*productpb.ProductVariant, error) {
+	resp, err := productCl.ViewProductVariant(ctx, &productpb.ViewProductVariantRequest{Id: variantId})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to get product variant from product service", err)
+	}
+
+	return resp.Variant, nil
+}
+
+// Scan a postgres row to a protobuf object
+func scanRowToCartItem(row pgx.Row) (*pb.CartItem, error) {
+	var item pb.CartItem
+
+	// Temporary variables that require conversion
+	var tmpCreatedAt pgtype.Timestamp
+	var tmpUpdatedAt pgtype.Timestamp
+
+	err := row.Scan(
+		&item.VariantId,
+		&item.Quantity,
+		&tmpCreatedAt,
+		&tmpUpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "cart item not found", err)
+		} else {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "something went wrong scanning cart item", err)
+		}
+	}
+
+	// Convert the temporary variables
+	//
+	// This includes converting postgres timestamps to unix format
+	if tmpCreatedAt.Valid {
+		item.CreatedAt = tmpCreatedAt.Time.Unix()
+	} else {
+		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to convert cart item (created_at) timestamp")
+	}
+
+	if tmpUpdatedAt.Valid {
+		unixUpdated := tmpUpdatedAt.Time.Unix()
+		item.UpdatedAt = &unixUpdated
+	}
+
+	return &item, nil
+}
@@ -16,17 +16,24 @@
 package order
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/hexolan/stocklet/internal/pkg/config"
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
 )
 
 // Order Service Configuration
 type ServiceConfig struct {
 	// Core Configuration
-	Shared config.SharedConfig
+	Shared      config.SharedConfig
+	ServiceOpts ServiceConfigOpts
 
 	// Dynamically loaded configuration
 	Postgres config.PostgresConfig
 	Kafka    config.KafkaConfig
+	FX       config.FXConfig
 }
 
 // load the base service configuration
@@ -38,5 +45,159 @@ func NewServiceConfig() (*ServiceConfig, error) {
 		return nil, err
 	}
 
+	// load the service config opts
+	if err := cfg.ServiceOpts.Load(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// Service specific config options
+type ServiceConfigOpts struct {
+	// Env Var: "USER_SERVICE_GRPC" (optional)
+	// Used to look up the preferred currency of customers.
+	// Orders are charged in the pricing currency when not set.
+	UserServiceGrpc *string
+
+	// Env Var: "PAYMENT_SERVICE_GRPC" (optional)
+	// Used to hold the payment tokens of orders paid by card or gift card.
+	// Orders can only be paid from the customer's balance when not set.
+	PaymentServiceGrpc *string
+
+	// Configuration for the order pricing pipeline
+	Pricing PricingConfig
+}
+
+// Load the ServiceConfigOpts
+func (opts *ServiceConfigOpts) Load() error {
+	if userServiceGrpc, err := config.RequireFromEnv("USER_SERVICE_GRPC"); err == nil {
+		opts.UserServiceGrpc = &userServiceGrpc
+	}
+
+	if paymentServiceGrpc, err := config.RequireFromEnv("PAYMENT_SERVICE_GRPC"); err == nil {
+		opts.PaymentServiceGrpc = &paymentServiceGrpc
+	}
+
+	return opts.Pricing.Load()
+}
+
+// Order pricing configuration
+type PricingConfig struct {
+	// Env Var: "PRICING_CURRENCY" (optional)
+	// ISO 4217 currency code that the configured amounts are specified in
+	// Defaults to "USD"
+	Currency string
+
+	// Env Var: "PRICING_SHIPPING_FEE" (optional)
+	// Flat shipping charge applied to orders (e.g. "4.99")
+	// Defaults to 0
+	ShippingFee int64
+
+	// Env Var: "PRICING_FREE_SHIPPING_THRESHOLD" (optional)
+	// Orders with an items price at (or above) this value are shipped for free
+	FreeShippingThreshold *int64
+
+	// Env Var: "PRICING_TAX_RATES" (optional)
+	// Rate table of Product ID to tax rate (e.g. "default=0.2,1=0.05")
+	// The "default" rate applies to products not in the table
+	TaxRates map[string]float64
+
+	// Env Var: "PRICING_DISCOUNT_TIERS" (optional)
+	// Rate table of items price threshold to discount rate (e.g. "100=0.05,250=0.1")
+	// The highest applicable discount is used
+	DiscountTiers map[int64]float64
+}
+
+// Load the PricingConfig
+//
+// Amounts are converted to the minor units of the pricing currency.
+func (cfg *PricingConfig) Load() error {
+	cfg.Currency = money.DefaultCurrency
+	if currency, err := config.RequireFromEnv("PRICING_CURRENCY"); err == nil {
+		cfg.Currency = strings.ToUpper(strings.TrimSpace(currency))
+	}
+
+	if shippingFee, err := config.RequireFromEnv("PRICING_SHIPPING_FEE"); err == nil {
+		value, err := parsePrice(shippingFee, cfg.Currency)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "invalid cfg option (PRICING_SHIPPING_FEE)", err)
+		}
+
+		cfg.ShippingFee = value
+	}
+
+	if freeShippingThreshold, err := config.RequireFromEnv("PRICING_FREE_SHIPPING_THRESHOLD"); err == nil {
+		value, err := parsePrice(freeShippingThreshold, cfg.Currency)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "invalid cfg option (PRICING_FREE_SHIPPING_THRESHOLD)", err)
+		}
+
+		cfg.FreeShippingThreshold = &value
+	}
+
+	cfg.TaxRates = make(map[string]float64)
+	if taxRates, err := config.RequireFromEnv("PRICING_TAX_RATES"); err == nil {
+		table, err := parseRateTable(taxRates)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "invalid cfg option (PRICING_TAX_RATES)", err)
+		}
+
+		cfg.TaxRates = table
+	}
+
+	cfg.DiscountTiers = make(map[int64]float64)
+	if discountTiers, err := config.RequireFromEnv("PRICING_DISCOUNT_TIERS"); err == nil {
+		table, err := parseRateTable(discountTiers)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "invalid cfg option (PRICING_DISCOUNT_TIERS)", err)
+		}
+
+		for threshold, rate := range table {
+			value, err := parsePrice(threshold, cfg.Currency)
+			if err != nil {
+				return errors.WrapServiceError(errors.ErrCodeService, "invalid cfg option (PRICING_DISCOUNT_TIERS)", err)
+			}
+
+			cfg.DiscountTiers[value] = rate
+		}
+	}
+
+	return nil
+}
+
+// Parse a non-negative price value (into the minor units of the currency)
+func parsePrice(value string, currency string) (int64, error) {
+	price, err := money.ParseDecimal(value, currency)
+	if err != nil {
+		return 0, err
+	} else if price < 0 {
+		return 0, errors.NewServiceErrorf(errors.ErrCodeService, "negative value (%s)", value)
+	}
+
+	return price, nil
+}
+
+// Parse a rate table in the form of "key=rate,key=rate"
+//
+// Rates are expected to be fractions between 0 and 1.
+func parseRateTable(value string) (map[string]float64, error) {
+	table := make(map[string]float64)
+	for _, entry := range strings.Split(value, ",") {
+		key, rawRate, found := strings.Cut(entry, "=")
+		if !found {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeService, "malformed rate table entry (%s)", entry)
+		}
+
+		rate, err := strconv.ParseFloat(strings.TrimSpace(rawRate), 64)
+		if err != nil {
+			return nil, err
+		} else if rate < 0 || rate > 1 {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeService, "rate out of bounds (%s)", entry)
+		}
+
+		table[strings.TrimSpace(key)] = rate
+	}
+
+	return table, nil
+}
@@ -49,10 +49,22 @@ func NewKafkaController(cl *kgo.Client) order.ConsumerController {
 		messaging.Order_State_Pending_Topic,
 		messaging.Order_State_Rejected_Topic,
 		messaging.Order_State_Approved_Topic,
+		messaging.Order_State_Completed_Topic,
+		messaging.Order_State_Cancelled_Topic,
+
+		messaging.Order_Return_Requested_Topic,
+		messaging.Order_Return_Approved_Topic,
+		messaging.Order_Return_Rejected_Topic,
+		messaging.Order_Return_Completed_Topic,
 
 		messaging.Warehouse_Reservation_Failed_Topic,
+		messaging.Warehouse_Reservation_Reserved_Topic,
 		messaging.Shipping_Shipment_Allocation_Topic,
 		messaging.Payment_Processing_Topic,
+		messaging.Warehouse_Stock_Added_Topic,
+		messaging.Payment_Refund_Topic,
+		messaging.Payment_Transaction_Captured_Topic,
+		messaging.Payment_Transaction_Voided_Topic,
 	)
 	if err != nil {
 		log.Warn().Err(err).Msg("kafka: raised attempting to ensure svc topics")
@@ -62,8 +74,13 @@ func NewKafkaController(cl *kgo.Client) order.ConsumerController {
 	cl.AddConsumeTopics(
 		messaging.Product_PriceQuotation_Topic,
 		messaging.Warehouse_Reservation_Failed_Topic,
+		messaging.Warehouse_Reservation_Reserved_Topic,
 		messaging.Shipping_Shipment_Allocation_Topic,
 		messaging.Payment_Processing_Topic,
+		messaging.Warehouse_Stock_Added_Topic,
+		messaging.Payment_Refund_Topic,
+		messaging.Payment_Transaction_Captured_Topic,
+		messaging.Payment_Transaction_Voided_Topic,
 	)
 
 	return &kafkaController{cl: cl, ctx: ctx, ctxCancel: ctxCancel}
@@ -88,12 +105,20 @@ func (c *kafkaController) Start() {
 			switch ft.Topic {
 			case messaging.Product_PriceQuotation_Topic:
 				c.consumeProductPriceQuoteEventTopic(ft)
-			case messaging.Warehouse_Reservation_Failed_Topic:
+			case messaging.Warehouse_Reservation_Failed_Topic, messaging.Warehouse_Reservation_Reserved_Topic:
 				c.consumeStockReservationEventTopic(ft)
 			case messaging.Shipping_Shipment_Allocation_Topic:
 				c.consumeShipmentAllocationEventTopic(ft)
 			case messaging.Payment_Processing_Topic:
 				c.consumePaymentProcessedEventTopic(ft)
+			case messaging.Warehouse_Stock_Added_Topic:
+				c.consumeStockAddedEventTopic(ft)
+			case messaging.Payment_Refund_Topic:
+				c.consumeRefundProcessedEventTopic(ft)
+			case messaging.Payment_Transaction_Captured_Topic:
+				c.consumeTransactionCapturedEventTopic(ft)
+			case messaging.Payment_Transaction_Voided_Topic:
+				c.consumeTransactionVoidedEventTopic(ft)
 			default:
 				log.Warn().Str("topic", ft.Topic).Msg("consumer: received records from unexpected topic")
 			}
@@ -177,3 +202,75 @@ func (c *kafkaController) consumePaymentProcessedEventTopic(ft kgo.FetchTopic) {
 		c.svc.ProcessPaymentProcessedEvent(ctx, &event)
 	})
 }
+
+func (c *kafkaController) consumeStockAddedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.StockAddedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessStockAddedEvent(ctx, &event)
+	})
+}
+
+func (c *kafkaController) consumeRefundProcessedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.RefundProcessedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessRefundProcessedEvent(ctx, &event)
+	})
+}
+
+func (c *kafkaController) consumeTransactionCapturedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.TransactionCapturedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessTransactionCapturedEvent(ctx, &event)
+	})
+}
+
+func (c *kafkaController) consumeTransactionVoidedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.TransactionVoidedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessTransactionVoidedEvent(ctx, &event)
+	})
+}
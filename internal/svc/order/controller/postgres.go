@@ -17,6 +17,11 @@ package controller
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/doug-martin/goqu/v9"
@@ -24,28 +29,61 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
+	paymentpb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+	userpb "github.com/hexolan/stocklet/internal/pkg/protogen/user/v1"
 	"github.com/hexolan/stocklet/internal/svc/order"
 )
 
 const (
-	pgOrderBaseQuery      string = "SELECT id, status, customer_id, shipping_id, transaction_id, created_at, updated_at FROM orders"
-	pgOrderItemsBaseQuery string = "SELECT product_id, quantity FROM order_items"
+	pgOrderBaseQuery      string = "SELECT id, status, customer_id, shipment_id, transaction_id, items_price, total_price, shipping_price, tax_price, discount_price, fulfilment_mode, adjustment_price, currency, exchange_rate, payment_method, created_at, updated_at FROM orders"
+	pgOrderItemsBaseQuery string = "SELECT variant_id, quantity, unit_price, line_total, dropped, backordered FROM order_items"
+
+	pgReturnBaseQuery      string = "SELECT id, order_id, customer_id, status, reason, refund_amount, currency, refund_id, restocked, created_at, updated_at FROM order_returns"
+	pgReturnItemsBaseQuery string = "SELECT variant_id, quantity, refund_amount FROM order_return_items"
+
+	// Channel that order status changes are notified on (with the order id as the payload)
+	pgOrderStatusChannel string = "order_status"
 )
 
+// The items of an order (as stored in the database)
+type orderItems struct {
+	// Variant ID: Quantity
+	quantities map[string]int32
+
+	// Variant ID: Price snapshot
+	prices map[string]*pb.OrderItemPrice
+
+	// Variant ID: Quantity (of items dropped or awaiting restock)
+	dropped     map[string]int32
+	backordered map[string]int32
+}
+
 // The postgres controller is responsible for implementing the StorageController interface
 // to store and retrieve the requested items from the Postgres database.
 //
 // Other controllers can be implemented to interface with different database systems.
 type postgresController struct {
 	cl *pgxpool.Pool
+
+	watcher *orderWatcher
+
+	serviceOpts *order.ServiceConfigOpts
 }
 
 // Creates a new postgresController that implements the StorageController interface.
-func NewPostgresController(cl *pgxpool.Pool) order.StorageController {
-	return postgresController{cl: cl}
+func NewPostgresController(cl *pgxpool.Pool, serviceOpts *order.ServiceConfigOpts) order.StorageController {
+	return postgresController{cl: cl, watcher: newOrderWatcher(cl), serviceOpts: serviceOpts}
 }
 
 // Internal method - Validation is assumed to have taken place already
@@ -78,9 +116,118 @@ func (c postgresController) getOrder(ctx context.Context, tx *pgx.Tx, orderId st
 	return order, nil
 }
 
+// Watch for status updates of an order.
+//
+// Notifications (sent upon status transitions) are received by the shared
+// order watcher, rather than by a connection per watch. The updated order
+// is sent upon each notification until the context is cancelled.
+func (c postgresController) WatchOrder(ctx context.Context, orderId string) (<-chan *pb.Order, error) {
+	signal, unsubscribe := c.watcher.Subscribe(orderId)
+
+	updates := make(chan *pb.Order)
+	go func() {
+		defer close(updates)
+		defer unsubscribe()
+
+		for {
+			select {
+			case <-signal:
+			case <-ctx.Done():
+				return
+			}
+
+			orderObj, err := c.getOrder(ctx, nil, orderId)
+			if err != nil {
+				return
+			}
+
+			select {
+			case updates <- orderObj:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return updates, nil
+}
+
 // Internal method - Inputs are assumed valid
 // Create a new order in the database
-func (c postgresController) CreateOrder(ctx context.Context, orderObj *pb.Order) (*pb.Order, error) {
+//
+// If an idempotency key is provided, then the key will be recorded
+// alongside the order. Repeated requests using the same key will
+// return the originally placed order.
+//
+// The payment token (if provided) is held by the payment service, with
+// only the reference to it stored to be passed on with the order.
+func (c postgresController) CreateOrder(ctx context.Context, orderObj *pb.Order, idempotencyKey *string, paymentToken *string) (*pb.Order, error) {
+	// Check if the idempotency key has already been used
+	cartHash := hashOrderCart(orderObj.Items)
+	if idempotencyKey != nil {
+		existingOrder, err := c.getOrderByIdempotencyKey(ctx, nil, orderObj.CustomerId, *idempotencyKey, cartHash)
+		if err != nil {
+			return nil, err
+		} else if existingOrder != nil {
+			return existingOrder, nil
+		}
+	}
+
+	// Prepare and perform insert query
+	newOrder := pb.Order{
+		Items:          orderObj.Items,
+		Status:         orderObj.Status,
+		CustomerId:     orderObj.CustomerId,
+		FulfilmentMode: orderObj.FulfilmentMode,
+		CurrencyCode:   orderObj.CurrencyCode,
+		PaymentMethod:  orderObj.PaymentMethod,
+		CreatedAt:      time.Now().Unix(),
+	}
+	if newOrder.FulfilmentMode == pb.FulfilmentMode_FULFILMENT_MODE_UNSPECIFIED {
+		newOrder.FulfilmentMode = pb.FulfilmentMode_FULFILMENT_MODE_COMPLETE
+	}
+	if newOrder.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED {
+		newOrder.PaymentMethod = commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE
+	}
+	if newOrder.CurrencyCode == "" {
+		newOrder.CurrencyCode = c.getPreferredCurrency(ctx, newOrder.CustomerId)
+	}
+
+	// Payment tokens are not required for payments from the balance
+	if paymentToken == nil || newOrder.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE {
+		return c.insertOrder(ctx, &newOrder, idempotencyKey, cartHash, nil)
+	}
+
+	// Reserve an id for the order
+	// (so that the payment token can be held before the order is inserted)
+	err := c.cl.QueryRow(ctx, "SELECT nextval(pg_get_serial_sequence('orders', 'id'))").Scan(&newOrder.Id)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create order", err)
+	}
+
+	// Hand the payment token over to the payment service
+	//
+	// This is performed outside of the DB transaction, with the
+	// token released if the order is not inserted.
+	paymentReference, err := c.holdPaymentToken(ctx, &newOrder, *paymentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	placedOrder, err := c.insertOrder(ctx, &newOrder, idempotencyKey, cartHash, &paymentReference)
+	if err != nil || placedOrder.Id != newOrder.Id {
+		// The order was not placed (or another order was placed with the idempotency key)
+		c.releasePaymentToken(ctx, newOrder.Id)
+	}
+
+	return placedOrder, err
+}
+
+// Insert a new order (and its items) in a DB transaction.
+//
+// The order is inserted with its reserved id, if one has been assigned. If the
+// idempotency key was recorded by a concurrent request, the original order is returned.
+func (c postgresController) insertOrder(ctx context.Context, newOrder *pb.Order, idempotencyKey *string, cartHash string, paymentReference *string) (*pb.Order, error) {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -88,23 +235,50 @@ func (c postgresController) CreateOrder(ctx context.Context, orderObj *pb.Order)
 	}
 	defer tx.Rollback(ctx)
 
-	// Prepare and perform insert query
-	newOrder := pb.Order{
-		Items:      orderObj.Items,
-		Status:     orderObj.Status,
-		CustomerId: orderObj.CustomerId,
-		CreatedAt:  time.Now().Unix(),
-	}
 	err = tx.QueryRow(
 		ctx,
-		"INSERT INTO orders (status, customer_id) VALUES ($1, $2) RETURNING id",
+		"INSERT INTO orders (id, status, customer_id, fulfilment_mode, currency, payment_method, payment_reference) VALUES (COALESCE(NULLIF($1, '')::bigint, nextval(pg_get_serial_sequence('orders', 'id'))), $2, $3, $4, $5, $6, $7) RETURNING id",
+		newOrder.Id,
 		newOrder.Status,
 		newOrder.CustomerId,
+		newOrder.FulfilmentMode,
+		newOrder.CurrencyCode,
+		newOrder.PaymentMethod,
+		paymentReference,
 	).Scan(&newOrder.Id)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create order", err)
 	}
 
+	// Record the idempotency key
+	if idempotencyKey != nil {
+		result, err := tx.Exec(
+			ctx,
+			"INSERT INTO order_idempotency_keys (customer_id, idempotency_key, order_id, cart_hash) VALUES ($1, $2, $3, $4) ON CONFLICT (customer_id, idempotency_key) DO NOTHING",
+			newOrder.CustomerId,
+			*idempotencyKey,
+			newOrder.Id,
+			cartHash,
+		)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to record idempotency key", err)
+		}
+
+		// The key was recorded by a concurrent request.
+		// Discard this order and return the original.
+		if result.RowsAffected() == 0 {
+			tx.Rollback(ctx)
+			existingOrder, err := c.getOrderByIdempotencyKey(ctx, nil, newOrder.CustomerId, *idempotencyKey, cartHash)
+			if err != nil {
+				return nil, err
+			} else if existingOrder == nil {
+				return nil, errors.NewServiceError(errors.ErrCodeService, "failed to locate order for idempotency key")
+			}
+
+			return existingOrder, nil
+		}
+	}
+
 	// Create records for any order items
 	err = c.createOrderItems(ctx, tx, newOrder.Id, newOrder.Items)
 	if err != nil {
@@ -117,7 +291,7 @@ func (c postgresController) CreateOrder(ctx context.Context, orderObj *pb.Order)
 	// Then add the event to the outbox table with the transaction
 	// to ensure that the event will be dispatched if
 	// the transaction succeeds.
-	evt, evtTopic, err := order.PrepareOrderCreatedEvent(&newOrder)
+	evt, evtTopic, err := order.PrepareOrderCreatedEvent(newOrder)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create order event", err)
 	}
@@ -133,7 +307,42 @@ func (c postgresController) CreateOrder(ctx context.Context, orderObj *pb.Order)
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
 	}
 
-	return &newOrder, nil
+	return newOrder, nil
+}
+
+// Get the order previously placed with an idempotency key.
+//
+// Returns nil if the key has not been used by the customer, or an error
+// if the key has been used for a different cart.
+func (c postgresController) getOrderByIdempotencyKey(ctx context.Context, tx *pgx.Tx, customerId string, idempotencyKey string, cartHash string) (*pb.Order, error) {
+	// Query the idempotency key
+	var row pgx.Row
+	const query = "SELECT order_id, cart_hash FROM order_idempotency_keys WHERE customer_id=$1 AND idempotency_key=$2"
+	if tx == nil {
+		row = c.cl.QueryRow(ctx, query, customerId, idempotencyKey)
+	} else {
+		row = (*tx).QueryRow(ctx, query, customerId, idempotencyKey)
+	}
+
+	var (
+		orderId      string
+		existingHash string
+	)
+	err := row.Scan(&orderId, &existingHash)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to check idempotency key", err)
+	}
+
+	// Ensure the key is not being reused for a different order
+	if existingHash != cartHash {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "idempotency key has already been used with a different cart")
+	}
+
+	return c.getOrder(ctx, tx, orderId)
 }
 
 // Get all orders related to a specified customer.
@@ -177,11 +386,16 @@ func (c postgresController) ApproveOrder(ctx context.Context, orderId string, tr
 	}
 	defer tx.Rollback(ctx)
 
+	// Transition the order status
+	err = c.transitionOrderStatus(ctx, tx, orderId, pb.OrderStatus_ORDER_STATUS_APPROVED)
+	if err != nil {
+		return nil, err
+	}
+
 	// Execute update query
 	_, err = tx.Exec(
 		ctx,
-		"UPDATE orders SET status = $1, transaction_id = $2 WHERE id = $3",
-		pb.OrderStatus_ORDER_STATUS_APPROVED,
+		"UPDATE orders SET transaction_id = $1 WHERE id = $2",
 		transactionId,
 		orderId,
 	)
@@ -214,9 +428,11 @@ func (c postgresController) ApproveOrder(ctx context.Context, orderId string, tr
 	return orderObj, nil
 }
 
-// Set order status to processing
-// Dispatch OrderProcessingEvent
-func (c postgresController) ProcessOrder(ctx context.Context, orderId string, itemsPrice float32) (*pb.Order, error) {
+// Set order status to pending (from processing)
+// Dispatch OrderPendingEvent
+//
+// The quoted unit prices are stored alongside each order item.
+func (c postgresController) ProcessOrder(ctx context.Context, orderId string, unitPrices map[string]*commonpb.Money, priceBreakdown *order.PriceBreakdown) (*pb.Order, error) {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -224,13 +440,29 @@ func (c postgresController) ProcessOrder(ctx context.Context, orderId string, it
 	}
 	defer tx.Rollback(ctx)
 
+	// Transition the order status
+	err = c.transitionOrderStatus(ctx, tx, orderId, pb.OrderStatus_ORDER_STATUS_PENDING)
+	if err != nil {
+		return nil, err
+	}
+
+	// Snapshot the quoted prices of the order items
+	err = c.setOrderItemPrices(ctx, tx, orderId, unitPrices)
+	if err != nil {
+		return nil, err
+	}
+
 	// Execute update query
 	_, err = tx.Exec(
 		ctx,
-		"UPDATE orders SET status = $1, items_price = $2, total_price = $3 WHERE id = $4",
-		pb.OrderStatus_ORDER_STATUS_PROCESSING,
-		itemsPrice,
-		itemsPrice,
+		"UPDATE orders SET items_price = $1, shipping_price = $2, tax_price = $3, discount_price = $4, total_price = $5, currency = $6, exchange_rate = $7 WHERE id = $8",
+		priceBreakdown.ItemsPrice,
+		priceBreakdown.ShippingPrice,
+		priceBreakdown.TaxPrice,
+		priceBreakdown.DiscountPrice,
+		priceBreakdown.TotalPrice,
+		priceBreakdown.Currency,
+		priceBreakdown.ExchangeRate,
 		orderId,
 	)
 	if err != nil {
@@ -242,9 +474,97 @@ func (c postgresController) ProcessOrder(ctx context.Context, orderId string, it
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update order", err)
 	}
 
+	// Get the payment reference to pass on with the order
+	var paymentReference *string
+	err = tx.QueryRow(ctx, "SELECT payment_reference FROM orders WHERE id=$1", orderId).Scan(&paymentReference)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to get order payment reference", err)
+	}
+
+	// Then add the event to the outbox table with the transaction.
+	evt, evtTopic, err := order.PrepareOrderPendingEvent(orderObj, paymentReference)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderObj.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return orderObj, nil
+}
+
+// Set order status to completed (from approved)
+// Dispatch OrderCompletedEvent
+func (c postgresController) CompleteOrder(ctx context.Context, orderId string) (*pb.Order, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Transition the order status
+	err = c.transitionOrderStatus(ctx, tx, orderId, pb.OrderStatus_ORDER_STATUS_COMPLETED)
+	if err != nil {
+		return nil, err
+	}
+
+	orderObj, err := c.getOrder(ctx, &tx, orderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to complete order", err)
+	}
+
+	// Then add the event to the outbox table with the transaction.
+	evt, evtTopic, err := order.PrepareOrderCompletedEvent(orderObj)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderObj.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return orderObj, nil
+}
+
+// Set order status to cancelled (from approved)
+// Dispatch OrderCancelledEvent
+func (c postgresController) CancelOrder(ctx context.Context, orderId string) (*pb.Order, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Transition the order status
+	err = c.transitionOrderStatus(ctx, tx, orderId, pb.OrderStatus_ORDER_STATUS_CANCELLED)
+	if err != nil {
+		return nil, err
+	}
+
+	orderObj, err := c.getOrder(ctx, &tx, orderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to cancel order", err)
+	}
+
 	// Then add the event to the outbox table with the transaction.
-	// todo: fix name discrepency (mixed up processing and pending in my wording)
-	evt, evtTopic, err := order.PrepareOrderPendingEvent(orderObj)
+	evt, evtTopic, err := order.PrepareOrderCancelledEvent(orderObj)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
@@ -263,7 +583,7 @@ func (c postgresController) ProcessOrder(ctx context.Context, orderId string, it
 	return orderObj, nil
 }
 
-// Set order status to rejected (from processing)
+// Set order status to rejected (from processing or pending)
 // Dispatch OrderRejectedEvent
 func (c postgresController) RejectOrder(ctx context.Context, orderId string) (*pb.Order, error) {
 	// Begin a DB transaction
@@ -273,20 +593,15 @@ func (c postgresController) RejectOrder(ctx context.Context, orderId string) (*p
 	}
 	defer tx.Rollback(ctx)
 
-	// Execute update query
-	_, err = tx.Exec(
-		ctx,
-		"UPDATE orders SET status = $1 WHERE id = $2",
-		pb.OrderStatus_ORDER_STATUS_REJECTED,
-		orderId,
-	)
+	// Transition the order status
+	err = c.transitionOrderStatus(ctx, tx, orderId, pb.OrderStatus_ORDER_STATUS_REJECTED)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to approve order", err)
+		return nil, err
 	}
 
 	orderObj, err := c.getOrder(ctx, &tx, orderId)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to approve order", err)
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to reject order", err)
 	}
 
 	// Then add the event to the outbox table with the transaction.
@@ -325,101 +640,815 @@ func (c postgresController) SetOrderShipmentId(ctx context.Context, orderId stri
 	return nil
 }
 
-// Build and exec an insert statement for a map of order items
-func (c postgresController) createOrderItems(ctx context.Context, tx pgx.Tx, orderId string, items map[string]int32) error {
-	// check there are items to add
-	if len(items) > 1 {
-		vals := [][]interface{}{}
-		for productId, quantity := range items {
-			vals = append(
-				vals,
-				goqu.Vals{orderId, productId, quantity},
-			)
-		}
+// Mark order items as dropped or backordered (due to insufficient stock)
+//
+// The order total is adjusted to account for any dropped items.
+func (c postgresController) SetOrderItemsShortfall(ctx context.Context, orderId string, droppedVariantIds []string, backorderedVariantIds []string, adjustedTotalPrice *commonpb.Money) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
 
-		statement, args, err := goqu.Dialect("postgres").From(
-			"order_items",
-		).Insert().Cols(
-			"order_id",
-			"product_id",
-			"quantity",
-		).Vals(
-			vals...,
-		).Prepared(
-			true,
-		).ToSQL()
-		if err != nil {
-			return errors.WrapServiceError(errors.ErrCodeService, "failed to build SQL statement", err)
-		}
+	// Mark the order items
+	_, err = tx.Exec(ctx, "UPDATE order_items SET dropped = TRUE WHERE order_id = $1 AND variant_id = ANY($2)", orderId, droppedVariantIds)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to mark dropped order items", err)
+	}
 
-		// Execute the statement on the transaction
-		_, err = tx.Exec(ctx, statement, args...)
+	_, err = tx.Exec(ctx, "UPDATE order_items SET backordered = TRUE WHERE order_id = $1 AND variant_id = ANY($2)", orderId, backorderedVariantIds)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to mark backordered order items", err)
+	}
+
+	// Apply the price adjustment (for dropped items)
+	if len(droppedVariantIds) > 0 {
+		_, err = tx.Exec(
+			ctx,
+			"UPDATE orders SET adjustment_price = total_price - $1, total_price = $1, updated_at = timezone('utc', now()) WHERE id = $2",
+			adjustedTotalPrice.GetMinorUnits(),
+			orderId,
+		)
 		if err != nil {
-			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to add items to order", err)
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to adjust order price", err)
 		}
 	}
 
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
 	return nil
 }
 
-func (c postgresController) getOrderItems(ctx context.Context, tx *pgx.Tx, orderId string) (*map[string]int32, error) {
-	// Determine if transaction is being used.
-	var rows pgx.Rows
-	var err error
+// Mark backordered items as filled
+func (c postgresController) FillBackorderedItems(ctx context.Context, orderId string, variantIds []string) error {
+	_, err := c.cl.Exec(ctx, "UPDATE order_items SET backordered = FALSE WHERE order_id = $1 AND variant_id = ANY($2)", orderId, variantIds)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fill backordered items", err)
+	}
+
+	return nil
+}
+
+// Gets an order return by its specified id from the database
+func (c postgresController) GetReturn(ctx context.Context, returnId string) (*pb.OrderReturn, error) {
+	return c.getReturn(ctx, nil, returnId)
+}
+
+func (c postgresController) getReturn(ctx context.Context, tx *pgx.Tx, returnId string) (*pb.OrderReturn, error) {
+	// Query return
+	var row pgx.Row
 	if tx == nil {
-		rows, err = c.cl.Query(ctx, pgOrderItemsBaseQuery+" WHERE order_id=$1", orderId)
+		row = c.cl.QueryRow(ctx, pgReturnBaseQuery+" WHERE id=$1", returnId)
 	} else {
-		rows, err = (*tx).Query(ctx, pgOrderItemsBaseQuery+" WHERE order_id=$1", orderId)
+		row = (*tx).QueryRow(ctx, pgReturnBaseQuery+" WHERE id=$1", returnId)
 	}
 
+	// Scan row to protobuf return
+	orderReturn, err := scanRowToOrderReturn(row)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching order items", err)
+		return nil, err
+	}
+
+	// Append the return items
+	orderReturn, err = c.appendItemsToReturnObj(ctx, tx, orderReturn)
+	if err != nil {
+		return nil, err
+	}
+
+	return orderReturn, nil
+}
+
+// Gets the returns opened against an order
+func (c postgresController) GetOrderReturns(ctx context.Context, orderId string) ([]*pb.OrderReturn, error) {
+	rows, err := c.cl.Query(ctx, pgReturnBaseQuery+" WHERE order_id=$1 ORDER BY created_at", orderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching order returns", err)
 	}
 
-	items := make(map[string]int32)
+	returns := []*pb.OrderReturn{}
 	for rows.Next() {
-		var (
-			itemId   string
-			quantity int32
-		)
-		err := rows.Scan(
-			&itemId,
-			&quantity,
-		)
+		orderReturn, err := scanRowToOrderReturn(rows)
 		if err != nil {
-			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to scan an order item", err)
+			return nil, err
 		}
 
-		items[itemId] = quantity
+		returns = append(returns, orderReturn)
 	}
 
 	if rows.Err() != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning order item rows", rows.Err())
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning order return rows", rows.Err())
 	}
 
-	return &items, nil
+	// Append the items of each return
+	for _, orderReturn := range returns {
+		_, err = c.appendItemsToReturnObj(ctx, nil, orderReturn)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return returns, nil
 }
 
-// Appends order items to an order object.
-func (c postgresController) appendItemsToOrderObj(ctx context.Context, tx *pgx.Tx, orderObj *pb.Order) (*pb.Order, error) {
-	// Load the order items
-	orderItems, err := c.getOrderItems(ctx, tx, orderObj.Id)
+// Open a return against the items of an order
+// Dispatch ReturnRequestedEvent
+//
+// The refund for each item is calculated from the line totals quoted when the order was placed.
+func (c postgresController) CreateReturn(ctx context.Context, orderId string, items map[string]int32, reason string) (*pb.OrderReturn, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
 	if err != nil {
-		return nil, err
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
 	}
+	defer tx.Rollback(ctx)
 
-	// Add the order items to the order protobuf
-	orderObj.Items = *orderItems
+	// Lock the order (preventing concurrent returns exceeding the ordered quantities)
+	_, err = tx.Exec(ctx, "SELECT id FROM orders WHERE id=$1 FOR UPDATE", orderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to lock order", err)
+	}
 
-	// Return the order
+	orderObj, err := c.getOrder(ctx, &tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !order.IsReturnableStatus(orderObj.Status) {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "returns can only be opened against completed orders")
+	}
+
+	// Ensure the items can be returned
+	returned, err := c.getReturnedQuantities(ctx, tx, orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	for variantId, quantity := range items {
+		orderedQuantity, ordered := orderObj.Items[variantId]
+		if !ordered {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "variant %s is not part of the order", variantId)
+		} else if _, dropped := orderObj.DroppedItems[variantId]; dropped {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "variant %s was dropped from the order", variantId)
+		} else if _, backordered := orderObj.BackorderedItems[variantId]; backordered {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "variant %s has not been shipped", variantId)
+		} else if quantity > orderedQuantity-returned[variantId] {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "cannot return more of variant %s than was ordered", variantId)
+		}
+	}
+
+	// Calculate the refund amounts
+	itemRefunds, refundAmount := order.PriceReturnItems(orderObj, items)
+
+	// Create the return
+	var returnId string
+	err = tx.QueryRow(
+		ctx,
+		"INSERT INTO order_returns (order_id, customer_id, status, reason, refund_amount, currency) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id",
+		orderId,
+		orderObj.CustomerId,
+		pb.ReturnStatus_RETURN_STATUS_REQUESTED,
+		reason,
+		refundAmount.MinorUnits,
+		refundAmount.CurrencyCode,
+	).Scan(&returnId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create return", err)
+	}
+
+	for variantId, quantity := range items {
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO order_return_items (return_id, variant_id, quantity, refund_amount) VALUES ($1, $2, $3, $4)",
+			returnId,
+			variantId,
+			quantity,
+			itemRefunds[variantId].GetMinorUnits(),
+		)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to add items to return", err)
+		}
+	}
+
+	orderReturn, err := c.getReturn(ctx, &tx, returnId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := order.PrepareReturnRequestedEvent(orderReturn)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderReturn.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return orderReturn, nil
+}
+
+// Get the quantities of each variant already returned (or awaiting return) from an order.
+func (c postgresController) getReturnedQuantities(ctx context.Context, tx pgx.Tx, orderId string) (map[string]int32, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT ri.variant_id, SUM(ri.quantity) FROM order_return_items ri
+		JOIN order_returns r ON r.id = ri.return_id
+		WHERE r.order_id = $1 AND r.status != $2
+		GROUP BY ri.variant_id`,
+		orderId,
+		pb.ReturnStatus_RETURN_STATUS_REJECTED,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "query error whilst fetching returned quantities", err)
+	}
+
+	returned := make(map[string]int32)
+	for rows.Next() {
+		var (
+			variantId string
+			quantity  int32
+		)
+		err := rows.Scan(&variantId, &quantity)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to scan returned quantity", err)
+		}
+
+		returned[variantId] = quantity
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning returned quantity rows", rows.Err())
+	}
+
+	return returned, nil
+}
+
+// Set return status to approved (from requested)
+// Dispatch ReturnApprovedEvent
+func (c postgresController) ApproveReturn(ctx context.Context, returnId string) (*pb.OrderReturn, error) {
+	return c.decideReturn(ctx, returnId, pb.ReturnStatus_RETURN_STATUS_APPROVED, order.PrepareReturnApprovedEvent)
+}
+
+// Set return status to rejected (from requested)
+// Dispatch ReturnRejectedEvent
+func (c postgresController) RejectReturn(ctx context.Context, returnId string) (*pb.OrderReturn, error) {
+	return c.decideReturn(ctx, returnId, pb.ReturnStatus_RETURN_STATUS_REJECTED, order.PrepareReturnRejectedEvent)
+}
+
+func (c postgresController) decideReturn(ctx context.Context, returnId string, status pb.ReturnStatus, prepareEvent func(*pb.OrderReturn) ([]byte, string, error)) (*pb.OrderReturn, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Transition the return status
+	err = c.transitionReturnStatus(ctx, tx, returnId, status)
+	if err != nil {
+		return nil, err
+	}
+
+	orderReturn, err := c.getReturn(ctx, &tx, returnId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := prepareEvent(orderReturn)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderReturn.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return orderReturn, nil
+}
+
+// Mark a returned item as restocked
+//
+// The return is completed once all items have been restocked and the refund processed.
+func (c postgresController) SetReturnItemRestocked(ctx context.Context, returnId string, variantId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, "UPDATE order_return_items SET restocked = TRUE WHERE return_id = $1 AND variant_id = $2", returnId, variantId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to mark return item as restocked", err)
+	}
+
+	// Mark the return as restocked once all of the items have been restocked
+	_, err = tx.Exec(
+		ctx,
+		`UPDATE order_returns SET restocked = TRUE, updated_at = timezone('utc', now())
+		WHERE id = $1 AND NOT EXISTS (SELECT 1 FROM order_return_items WHERE return_id = $1 AND restocked = FALSE)`,
+		returnId,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to mark return as restocked", err)
+	}
+
+	err = c.completeReturnIfSettled(ctx, tx, returnId)
+	if err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Record the refund of a return
+//
+// The return is completed once all items have been restocked and the refund processed.
+func (c postgresController) SetReturnRefunded(ctx context.Context, returnId string, refundId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, "UPDATE order_returns SET refund_id = $1, updated_at = timezone('utc', now()) WHERE id = $2", refundId, returnId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to record return refund", err)
+	}
+
+	err = c.completeReturnIfSettled(ctx, tx, returnId)
+	if err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Set return status to completed (from approved), if restocked and refunded
+// Dispatch ReturnCompletedEvent
+func (c postgresController) completeReturnIfSettled(ctx context.Context, tx pgx.Tx, returnId string) error {
+	orderReturn, err := c.getReturn(ctx, &tx, returnId)
+	if err != nil {
+		return err
+	}
+
+	if orderReturn.Status != pb.ReturnStatus_RETURN_STATUS_APPROVED || !orderReturn.Restocked || orderReturn.RefundId == nil {
+		return nil
+	}
+
+	err = c.transitionReturnStatus(ctx, tx, returnId, pb.ReturnStatus_RETURN_STATUS_COMPLETED)
+	if err != nil {
+		return err
+	}
+	orderReturn.Status = pb.ReturnStatus_RETURN_STATUS_COMPLETED
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := order.PrepareReturnCompletedEvent(orderReturn)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderReturn.Id, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return nil
+}
+
+// Transition the status of an order return (as part of a transaction)
+//
+// Transitions not permitted by the return state machine are rejected.
+func (c postgresController) transitionReturnStatus(ctx context.Context, tx pgx.Tx, returnId string, status pb.ReturnStatus) error {
+	// Lock the return and get the current status
+	var currentStatus pb.ReturnStatus
+	err := tx.QueryRow(ctx, "SELECT status FROM order_returns WHERE id=$1 FOR UPDATE", returnId).Scan(&currentStatus)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.WrapServiceError(errors.ErrCodeNotFound, "return not found", err)
+		}
+
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to lock return", err)
+	}
+
+	// Ensure the transition is permitted
+	err = order.ValidateReturnStatusTransition(currentStatus, status)
+	if err != nil {
+		log.Warn().Err(err).Str("return_id", returnId).Str("from", currentStatus.String()).Str("to", status.String()).Msg("rejected return status transition")
+		return err
+	}
+
+	// Update the status
+	_, err = tx.Exec(
+		ctx,
+		"UPDATE order_returns SET status = $1, updated_at = timezone('utc', now()) WHERE id = $2",
+		status,
+		returnId,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update return status", err)
+	}
+
+	return nil
+}
+
+// Transition the status of an order (as part of a transaction)
+//
+// The order row is locked for the remainder of the transaction, preventing
+// concurrent updates from acting upon a stale status.
+//
+// Transitions not permitted by the order state machine are rejected.
+func (c postgresController) transitionOrderStatus(ctx context.Context, tx pgx.Tx, orderId string, status pb.OrderStatus) error {
+	// Lock the order and get the current status
+	var currentStatus pb.OrderStatus
+	err := tx.QueryRow(ctx, "SELECT status FROM orders WHERE id=$1 FOR UPDATE", orderId).Scan(&currentStatus)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.WrapServiceError(errors.ErrCodeNotFound, "order not found", err)
+		}
+
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to lock order", err)
+	}
+
+	// Ensure the transition is permitted
+	err = order.ValidateStatusTransition(currentStatus, status)
+	if err != nil {
+		log.Warn().Err(err).Str("order_id", orderId).Str("from", currentStatus.String()).Str("to", status.String()).Msg("rejected order status transition")
+		return err
+	}
+
+	// Update the status
+	_, err = tx.Exec(
+		ctx,
+		"UPDATE orders SET status = $1, updated_at = timezone('utc', now()) WHERE id = $2",
+		status,
+		orderId,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update order status", err)
+	}
+
+	// Notify any watchers (once the transaction is committed)
+	_, err = tx.Exec(ctx, "SELECT pg_notify($1, $2)", pgOrderStatusChannel, orderId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to notify order status", err)
+	}
+
+	return nil
+}
+
+// Build and exec an insert statement for a map of order items
+func (c postgresController) createOrderItems(ctx context.Context, tx pgx.Tx, orderId string, items map[string]int32) error {
+	// check there are items to add
+	if len(items) > 0 {
+		vals := [][]interface{}{}
+		for variantId, quantity := range items {
+			vals = append(
+				vals,
+				goqu.Vals{orderId, variantId, quantity},
+			)
+		}
+
+		statement, args, err := goqu.Dialect("postgres").From(
+			"order_items",
+		).Insert().Cols(
+			"order_id",
+			"variant_id",
+			"quantity",
+		).Vals(
+			vals...,
+		).Prepared(
+			true,
+		).ToSQL()
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "failed to build SQL statement", err)
+		}
+
+		// Execute the statement on the transaction
+		_, err = tx.Exec(ctx, statement, args...)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to add items to order", err)
+		}
+	}
+
+	return nil
+}
+
+// Set the quoted unit price (and resulting line total) of each order item
+func (c postgresController) setOrderItemPrices(ctx context.Context, tx pgx.Tx, orderId string, unitPrices map[string]*commonpb.Money) error {
+	for variantId, unitPrice := range unitPrices {
+		_, err := tx.Exec(
+			ctx,
+			"UPDATE order_items SET unit_price = $1, line_total = $1 * quantity WHERE order_id = $2 AND variant_id = $3",
+			unitPrice.GetMinorUnits(),
+			orderId,
+			variantId,
+		)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to set order item prices", err)
+		}
+	}
+
+	return nil
+}
+
+// Get the items of an order
+//
+// Item prices are in the currency of the order.
+func (c postgresController) getOrderItems(ctx context.Context, tx *pgx.Tx, orderId string, currency string) (*orderItems, error) {
+	// Determine if transaction is being used.
+	var rows pgx.Rows
+	var err error
+	if tx == nil {
+		rows, err = c.cl.Query(ctx, pgOrderItemsBaseQuery+" WHERE order_id=$1", orderId)
+	} else {
+		rows, err = (*tx).Query(ctx, pgOrderItemsBaseQuery+" WHERE order_id=$1", orderId)
+	}
+
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching order items", err)
+	}
+
+	items := orderItems{
+		quantities:  make(map[string]int32),
+		prices:      make(map[string]*pb.OrderItemPrice),
+		dropped:     make(map[string]int32),
+		backordered: make(map[string]int32),
+	}
+	for rows.Next() {
+		var (
+			itemId      string
+			quantity    int32
+			unitPrice   *int64
+			lineTotal   *int64
+			dropped     bool
+			backordered bool
+		)
+		err := rows.Scan(
+			&itemId,
+			&quantity,
+			&unitPrice,
+			&lineTotal,
+			&dropped,
+			&backordered,
+		)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to scan an order item", err)
+		}
+
+		items.quantities[itemId] = quantity
+
+		// Prices are only present once the order has been priced
+		if unitPrice != nil && lineTotal != nil {
+			items.prices[itemId] = &pb.OrderItemPrice{
+				UnitPrice: money.New(*unitPrice, currency),
+				LineTotal: money.New(*lineTotal, currency),
+			}
+		}
+
+		if dropped {
+			items.dropped[itemId] = quantity
+		} else if backordered {
+			items.backordered[itemId] = quantity
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning order item rows", rows.Err())
+	}
+
+	return &items, nil
+}
+
+// Appends order items to an order object.
+func (c postgresController) appendItemsToOrderObj(ctx context.Context, tx *pgx.Tx, orderObj *pb.Order) (*pb.Order, error) {
+	// Load the order items
+	items, err := c.getOrderItems(ctx, tx, orderObj.Id, orderObj.TotalPrice.GetCurrencyCode())
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the order items to the order protobuf
+	orderObj.Items = items.quantities
+	orderObj.ItemPrices = items.prices
+	orderObj.DroppedItems = items.dropped
+	orderObj.BackorderedItems = items.backordered
+
+	// Return the order
 	return orderObj, nil
 }
 
+// Appends return items to an order return object.
+func (c postgresController) appendItemsToReturnObj(ctx context.Context, tx *pgx.Tx, orderReturn *pb.OrderReturn) (*pb.OrderReturn, error) {
+	// Determine if transaction is being used.
+	var rows pgx.Rows
+	var err error
+	if tx == nil {
+		rows, err = c.cl.Query(ctx, pgReturnItemsBaseQuery+" WHERE return_id=$1", orderReturn.Id)
+	} else {
+		rows, err = (*tx).Query(ctx, pgReturnItemsBaseQuery+" WHERE return_id=$1", orderReturn.Id)
+	}
+
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching return items", err)
+	}
+
+	orderReturn.Items = make(map[string]int32)
+	orderReturn.ItemRefunds = make(map[string]*commonpb.Money)
+	for rows.Next() {
+		var (
+			itemId       string
+			quantity     int32
+			refundAmount int64
+		)
+		err := rows.Scan(&itemId, &quantity, &refundAmount)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to scan a return item", err)
+		}
+
+		orderReturn.Items[itemId] = quantity
+		orderReturn.ItemRefunds[itemId] = money.New(refundAmount, orderReturn.RefundAmount.GetCurrencyCode())
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning return item rows", rows.Err())
+	}
+
+	return orderReturn, nil
+}
+
+// Hash the contents of an order cart.
+//
+// Used to detect idempotency keys being reused for different orders.
+func hashOrderCart(items map[string]int32) string {
+	entries := make([]string, 0, len(items))
+	for variantId, quantity := range items {
+		entries = append(entries, fmt.Sprintf("%s:%d", variantId, quantity))
+	}
+	sort.Strings(entries)
+
+	hash := sha256.Sum256([]byte(strings.Join(entries, ",")))
+	return hex.EncodeToString(hash[:])
+}
+
+// Get the preferred currency of a customer from the user service.
+//
+// Falls back to the pricing currency if the user service is not configured
+// or the preference could not be retrieved.
+func (c postgresController) getPreferredCurrency(ctx context.Context, customerId string) string {
+	if c.serviceOpts.UserServiceGrpc == nil {
+		return c.serviceOpts.Pricing.Currency
+	}
+
+	// Establish connection with user service
+	userConn, err := grpc.Dial(
+		*c.serviceOpts.UserServiceGrpc,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to establish connection to user service")
+		return c.serviceOpts.Pricing.Currency
+	}
+	defer userConn.Close()
+	userCl := userpb.NewUserServiceClient(userConn)
+
+	userCtx, userCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer userCtxCancel()
+
+	resp, err := userCl.ViewUser(userCtx, &userpb.ViewUserRequest{Id: customerId})
+	if err != nil || resp.GetUser().GetPreferredCurrency() == "" {
+		log.Warn().Err(err).Str("customer_id", customerId).Msg("unable to get preferred currency of customer")
+		return c.serviceOpts.Pricing.Currency
+	}
+
+	return resp.GetUser().GetPreferredCurrency()
+}
+
+// Hold the payment token of an order with the payment service.
+//
+// The token is not stored by the order service. The returned
+// reference is resolved by the payment service upon payment.
+func (c postgresController) holdPaymentToken(ctx context.Context, orderObj *pb.Order, paymentToken string) (string, error) {
+	if c.serviceOpts.PaymentServiceGrpc == nil {
+		return "", errors.NewServiceError(errors.ErrCodeInvalidArgument, "payment method unavailable: payments can only be taken from the balance")
+	}
+
+	// Establish connection with payment service
+	paymentConn, err := grpc.Dial(
+		*c.serviceOpts.PaymentServiceGrpc,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeExtService, "failed to establish connection to payment service", err)
+	}
+	defer paymentConn.Close()
+	paymentCl := paymentpb.NewPaymentServiceClient(paymentConn)
+
+	paymentCtx, paymentCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer paymentCtxCancel()
+
+	resp, err := paymentCl.HoldPaymentToken(paymentCtx, &paymentpb.HoldPaymentTokenRequest{
+		OrderId:       orderObj.Id,
+		CustomerId:    orderObj.CustomerId,
+		PaymentMethod: orderObj.PaymentMethod,
+		PaymentToken:  paymentToken,
+	})
+	if err != nil {
+		// Provide invalid argument errors (e.g. an unknown gift card) to the user.
+		if st, ok := status.FromError(err); ok && st.Code() == codes.InvalidArgument {
+			return "", errors.WrapServiceError(errors.ErrCodeInvalidArgument, st.Message(), err)
+		}
+
+		return "", errors.WrapServiceError(errors.ErrCodeExtService, "failed to hold payment token", err)
+	}
+
+	return resp.PaymentReference, nil
+}
+
+// Release the payment token held for an order that could not be placed.
+//
+// Failures are logged, as the token will otherwise be cleared upon expiry.
+func (c postgresController) releasePaymentToken(ctx context.Context, orderId string) {
+	// Establish connection with payment service
+	paymentConn, err := grpc.Dial(
+		*c.serviceOpts.PaymentServiceGrpc,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		log.Error().Err(err).Str("order_id", orderId).Msg("failed to establish connection to payment service")
+		return
+	}
+	defer paymentConn.Close()
+	paymentCl := paymentpb.NewPaymentServiceClient(paymentConn)
+
+	paymentCtx, paymentCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer paymentCtxCancel()
+
+	_, err = paymentCl.ReleasePaymentToken(paymentCtx, &paymentpb.ReleasePaymentTokenRequest{OrderId: orderId})
+	if err != nil {
+		log.Error().Err(err).Str("order_id", orderId).Msg("failed to release payment token")
+	}
+}
+
+// Convert a (nullable) minor unit price to a money object.
+func toMoney(minorUnits *int64, currency *string) *commonpb.Money {
+	if minorUnits == nil || currency == nil {
+		return nil
+	}
+
+	return money.New(*minorUnits, *currency)
+}
+
 // Scan a postgres row to a protobuf order object.
 func scanRowToOrder(row pgx.Row) (*pb.Order, error) {
 	var order pb.Order
 
 	// Temporary variables that require conversion
+	var (
+		tmpItemsPrice      *int64
+		tmpTotalPrice      *int64
+		tmpShippingPrice   *int64
+		tmpTaxPrice        *int64
+		tmpDiscountPrice   *int64
+		tmpAdjustmentPrice *int64
+		tmpCurrency        *string
+	)
 	var tmpCreatedAt pgtype.Timestamp
 	var tmpUpdatedAt pgtype.Timestamp
 
@@ -429,6 +1458,16 @@ func scanRowToOrder(row pgx.Row) (*pb.Order, error) {
 		&order.CustomerId,
 		&order.ShippingId,
 		&order.TransactionId,
+		&tmpItemsPrice,
+		&tmpTotalPrice,
+		&tmpShippingPrice,
+		&tmpTaxPrice,
+		&tmpDiscountPrice,
+		&order.FulfilmentMode,
+		&tmpAdjustmentPrice,
+		&tmpCurrency,
+		&order.ExchangeRate,
+		&order.PaymentMethod,
 		&tmpCreatedAt,
 		&tmpUpdatedAt,
 	)
@@ -442,7 +1481,18 @@ func scanRowToOrder(row pgx.Row) (*pb.Order, error) {
 
 	// Convert the temporary variables
 	//
-	// This includes converting postgres timestamps to unix format
+	// This includes converting minor unit prices to money objects
+	// and postgres timestamps to unix format
+	order.ItemsPrice = toMoney(tmpItemsPrice, tmpCurrency)
+	order.TotalPrice = toMoney(tmpTotalPrice, tmpCurrency)
+	order.ShippingPrice = toMoney(tmpShippingPrice, tmpCurrency)
+	order.TaxPrice = toMoney(tmpTaxPrice, tmpCurrency)
+	order.DiscountPrice = toMoney(tmpDiscountPrice, tmpCurrency)
+	order.AdjustmentPrice = toMoney(tmpAdjustmentPrice, tmpCurrency)
+	if tmpCurrency != nil {
+		order.CurrencyCode = *tmpCurrency
+	}
+
 	if tmpCreatedAt.Valid {
 		order.CreatedAt = tmpCreatedAt.Time.Unix()
 	} else {
@@ -456,3 +1506,54 @@ func scanRowToOrder(row pgx.Row) (*pb.Order, error) {
 
 	return &order, nil
 }
+
+// Scan a postgres row to a protobuf object
+func scanRowToOrderReturn(row pgx.Row) (*pb.OrderReturn, error) {
+	var orderReturn pb.OrderReturn
+
+	// Temporary variables that require conversion
+	var tmpRefundAmount int64
+	var tmpCurrency string
+	var tmpCreatedAt pgtype.Timestamp
+	var tmpUpdatedAt pgtype.Timestamp
+
+	err := row.Scan(
+		&orderReturn.Id,
+		&orderReturn.OrderId,
+		&orderReturn.CustomerId,
+		&orderReturn.Status,
+		&orderReturn.Reason,
+		&tmpRefundAmount,
+		&tmpCurrency,
+		&orderReturn.RefundId,
+		&orderReturn.Restocked,
+		&tmpCreatedAt,
+		&tmpUpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "return not found", err)
+		} else {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "something went wrong scanning return", err)
+		}
+	}
+
+	// Convert the temporary variables
+	//
+	// This includes converting minor unit amounts to money objects
+	// and postgres timestamps to unix format
+	orderReturn.RefundAmount = money.New(tmpRefundAmount, tmpCurrency)
+
+	if tmpCreatedAt.Valid {
+		orderReturn.CreatedAt = tmpCreatedAt.Time.Unix()
+	} else {
+		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to convert return (created_at) timestamp")
+	}
+
+	if tmpUpdatedAt.Valid {
+		unixUpdated := tmpUpdatedAt.Time.Unix()
+		orderReturn.UpdatedAt = &unixUpdated
+	}
+
+	return &orderReturn, nil
+}
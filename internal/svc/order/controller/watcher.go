@@ -0,0 +1,148 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog/log"
+)
+
+// The order watcher holds a single dedicated connection (outside of the pool)
+// which listens for order status notifications, and fans them out to the
+// subscribers of each order.
+//
+// The connection is opened upon the first subscription, and is re-established
+// if lost. Subscribers are signalled after reconnecting, as notifications may
+// have been missed in the meantime.
+type orderWatcher struct {
+	cl *pgxpool.Pool
+
+	startOnce sync.Once
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+func newOrderWatcher(cl *pgxpool.Pool) *orderWatcher {
+	return &orderWatcher{cl: cl, subscribers: make(map[string]map[chan struct{}]struct{})}
+}
+
+// Subscribe to status changes of an order.
+//
+// The returned channel is signalled (without blocking) whenever the status
+// of the order may have changed. The returned function ends the subscription.
+func (w *orderWatcher) Subscribe(orderId string) (<-chan struct{}, func()) {
+	w.startOnce.Do(func() { go w.listen() })
+
+	signal := make(chan struct{}, 1)
+
+	w.mu.Lock()
+	if w.subscribers[orderId] == nil {
+		w.subscribers[orderId] = make(map[chan struct{}]struct{})
+	}
+	w.subscribers[orderId][signal] = struct{}{}
+	w.mu.Unlock()
+
+	unsubscribe := func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		delete(w.subscribers[orderId], signal)
+		if len(w.subscribers[orderId]) == 0 {
+			delete(w.subscribers, orderId)
+		}
+	}
+
+	return signal, unsubscribe
+}
+
+// Signal the subscribers of a specific order.
+func (w *orderWatcher) notify(orderId string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for signal := range w.subscribers[orderId] {
+		select {
+		case signal <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Signal every subscriber.
+func (w *orderWatcher) notifyAll() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, signals := range w.subscribers {
+		for signal := range signals {
+			select {
+			case signal <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// Listen for notifications for the lifetime of the service.
+func (w *orderWatcher) listen() {
+	retryDelay := time.Second
+	for {
+		connected, err := w.listenOnce()
+		log.Error().Err(err).Msg("order status listener disconnected")
+
+		if connected {
+			retryDelay = time.Second
+		}
+
+		time.Sleep(retryDelay)
+		if retryDelay < 30*time.Second {
+			retryDelay *= 2
+		}
+	}
+}
+
+func (w *orderWatcher) listenOnce() (bool, error) {
+	ctx := context.Background()
+
+	conn, err := pgx.ConnectConfig(ctx, w.cl.Config().ConnConfig)
+	if err != nil {
+		return false, err
+	}
+	defer conn.Close(ctx)
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{pgOrderStatusChannel}.Sanitize())
+	if err != nil {
+		return false, err
+	}
+
+	// Updates may have been missed while disconnected
+	w.notifyAll()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return true, err
+		}
+
+		w.notify(notification.Payload)
+	}
+}
@@ -17,6 +17,7 @@ package order
 
 import (
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventspb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
 )
@@ -24,24 +25,39 @@ import (
 func PrepareOrderCreatedEvent(order *pb.Order) ([]byte, string, error) {
 	topic := messaging.Order_State_Created_Topic
 	event := &eventspb.OrderCreatedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		OrderId:        order.Id,
 		CustomerId:     order.CustomerId,
 		ItemQuantities: order.Items,
+		CurrencyCode:   order.CurrencyCode,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareOrderPendingEvent(order *pb.Order) ([]byte, string, error) {
+func PrepareOrderPendingEvent(order *pb.Order, paymentReference *string) ([]byte, string, error) {
 	topic := messaging.Order_State_Pending_Topic
 	event := &eventspb.OrderPendingEvent{
-		Revision: 1,
+		Revision: 3,
 
-		OrderId:        order.Id,
-		CustomerId:     order.CustomerId,
-		ItemQuantities: order.Items,
+		OrderId:          order.Id,
+		CustomerId:       order.CustomerId,
+		ItemQuantities:   order.Items,
+		ItemsPrice:       order.GetItemsPrice(),
+		TotalPrice:       order.GetTotalPrice(),
+		ItemUnitPrices:   make(map[string]*commonpb.Money),
+		ItemLineTotals:   make(map[string]*commonpb.Money),
+		FulfilmentMode:   eventspb.OrderPendingEvent_FulfilmentMode(order.FulfilmentMode),
+		ShippingPrice:    order.GetShippingPrice(),
+		PaymentMethod:    order.PaymentMethod,
+		PaymentReference: paymentReference,
+	}
+
+	// Include the price snapshot of each item
+	for variantId, itemPrice := range order.ItemPrices {
+		event.ItemUnitPrices[variantId] = itemPrice.UnitPrice
+		event.ItemLineTotals[variantId] = itemPrice.LineTotal
 	}
 
 	return messaging.MarshalEvent(event, topic)
@@ -60,6 +76,19 @@ func PrepareOrderRejectedEvent(order *pb.Order) ([]byte, string, error) {
 	return messaging.MarshalEvent(event, topic)
 }
 
+func PrepareOrderCancelledEvent(order *pb.Order) ([]byte, string, error) {
+	topic := messaging.Order_State_Cancelled_Topic
+	event := &eventspb.OrderCancelledEvent{
+		Revision: 1,
+
+		OrderId:       order.Id,
+		TransactionId: order.TransactionId,
+		ShippingId:    order.ShippingId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
 func PrepareOrderApprovedEvent(order *pb.Order) ([]byte, string, error) {
 	topic := messaging.Order_State_Approved_Topic
 	event := &eventspb.OrderApprovedEvent{
@@ -72,3 +101,74 @@ func PrepareOrderApprovedEvent(order *pb.Order) ([]byte, string, error) {
 
 	return messaging.MarshalEvent(event, topic)
 }
+
+func PrepareOrderCompletedEvent(order *pb.Order) ([]byte, string, error) {
+	topic := messaging.Order_State_Completed_Topic
+	event := &eventspb.OrderCompletedEvent{
+		Revision: 1,
+
+		OrderId:       order.Id,
+		TransactionId: order.GetTransactionId(),
+		ShippingId:    order.GetShippingId(),
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareReturnRequestedEvent(orderReturn *pb.OrderReturn) ([]byte, string, error) {
+	topic := messaging.Order_Return_Requested_Topic
+	event := &eventspb.ReturnRequestedEvent{
+		Revision: 2,
+
+		ReturnId:       orderReturn.Id,
+		OrderId:        orderReturn.OrderId,
+		CustomerId:     orderReturn.CustomerId,
+		ItemQuantities: orderReturn.Items,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareReturnApprovedEvent(orderReturn *pb.OrderReturn) ([]byte, string, error) {
+	topic := messaging.Order_Return_Approved_Topic
+	event := &eventspb.ReturnApprovedEvent{
+		Revision: 3,
+
+		ReturnId:       orderReturn.Id,
+		OrderId:        orderReturn.OrderId,
+		CustomerId:     orderReturn.CustomerId,
+		ItemQuantities: orderReturn.Items,
+		ItemRefunds:    orderReturn.ItemRefunds,
+		RefundAmount:   orderReturn.RefundAmount,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareReturnRejectedEvent(orderReturn *pb.OrderReturn) ([]byte, string, error) {
+	topic := messaging.Order_Return_Rejected_Topic
+	event := &eventspb.ReturnRejectedEvent{
+		Revision: 1,
+
+		ReturnId:   orderReturn.Id,
+		OrderId:    orderReturn.OrderId,
+		CustomerId: orderReturn.CustomerId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareReturnCompletedEvent(orderReturn *pb.OrderReturn) ([]byte, string, error) {
+	topic := messaging.Order_Return_Completed_Topic
+	event := &eventspb.ReturnCompletedEvent{
+		Revision: 2,
+
+		ReturnId:     orderReturn.Id,
+		OrderId:      orderReturn.OrderId,
+		CustomerId:   orderReturn.CustomerId,
+		RefundId:     orderReturn.GetRefundId(),
+		RefundAmount: orderReturn.RefundAmount,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
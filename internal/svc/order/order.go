@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/fx"
 	"github.com/hexolan/stocklet/internal/pkg/gwauth"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
 	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
@@ -36,6 +37,9 @@ type OrderService struct {
 
 	store StorageController
 	pbVal *protovalidate.Validator
+
+	pricingCfg *PricingConfig
+	rates      fx.RateProvider
 }
 
 // Interface for database methods
@@ -43,12 +47,27 @@ type OrderService struct {
 type StorageController interface {
 	GetOrder(ctx context.Context, orderId string) (*pb.Order, error)
 	GetCustomerOrders(ctx context.Context, customerId string) ([]*pb.Order, error)
+	WatchOrder(ctx context.Context, orderId string) (<-chan *pb.Order, error)
 
-	CreateOrder(ctx context.Context, order *pb.Order) (*pb.Order, error)
+	CreateOrder(ctx context.Context, order *pb.Order, idempotencyKey *string, paymentToken *string) (*pb.Order, error)
 	ApproveOrder(ctx context.Context, orderId string, transactionId string) (*pb.Order, error)
-	ProcessOrder(ctx context.Context, orderId string, itemsPrice float32) (*pb.Order, error)
+	ProcessOrder(ctx context.Context, orderId string, unitPrices map[string]*commonpb.Money, priceBreakdown *PriceBreakdown) (*pb.Order, error)
 	RejectOrder(ctx context.Context, orderId string) (*pb.Order, error)
+	CompleteOrder(ctx context.Context, orderId string) (*pb.Order, error)
+	CancelOrder(ctx context.Context, orderId string) (*pb.Order, error)
 	SetOrderShipmentId(ctx context.Context, orderId string, shippingId string) error
+
+	SetOrderItemsShortfall(ctx context.Context, orderId string, droppedVariantIds []string, backorderedVariantIds []string, adjustedTotalPrice *commonpb.Money) error
+	FillBackorderedItems(ctx context.Context, orderId string, variantIds []string) error
+
+	GetReturn(ctx context.Context, returnId string) (*pb.OrderReturn, error)
+	GetOrderReturns(ctx context.Context, orderId string) ([]*pb.OrderReturn, error)
+
+	CreateReturn(ctx context.Context, orderId string, items map[string]int32, reason string) (*pb.OrderReturn, error)
+	ApproveReturn(ctx context.Context, returnId string) (*pb.OrderReturn, error)
+	RejectReturn(ctx context.Context, returnId string) (*pb.OrderReturn, error)
+	SetReturnItemRestocked(ctx context.Context, returnId string, variantId string) error
+	SetReturnRefunded(ctx context.Context, returnId string, refundId string) error
 }
 
 // Interface for event consumption
@@ -60,7 +79,7 @@ type ConsumerController interface {
 }
 
 // Create the order service
-func NewOrderService(cfg *ServiceConfig, store StorageController) *OrderService {
+func NewOrderService(cfg *ServiceConfig, store StorageController, rates fx.RateProvider) *OrderService {
 	// Initialise the protobuf validator
 	pbVal, err := protovalidate.New()
 	if err != nil {
@@ -69,8 +88,10 @@ func NewOrderService(cfg *ServiceConfig, store StorageController) *OrderService
 
 	// Initialise the service
 	return &OrderService{
-		store: store,
-		pbVal: pbVal,
+		store:      store,
+		pbVal:      pbVal,
+		pricingCfg: &cfg.ServiceOpts.Pricing,
+		rates:      rates,
 	}
 }
 
@@ -114,6 +135,73 @@ func (svc OrderService) ViewOrders(ctx context.Context, req *pb.ViewOrdersReques
 	return &pb.ViewOrdersResponse{Orders: orders}, nil
 }
 
+func (svc OrderService) WatchOrder(req *pb.WatchOrderRequest, stream pb.OrderService_WatchOrderServer) error {
+	ctx := stream.Context()
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Get the current state of the order
+	order, err := svc.store.GetOrder(ctx, req.OrderId)
+	if err != nil {
+		return err
+	}
+
+	// If the request is through the gateway, then ensure the user owns the order
+	//
+	// This is checked before any updates are subscribed to.
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return err
+		}
+
+		if order.CustomerId != claims.Subject {
+			return errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to view this order")
+		}
+	}
+
+	// Begin watching for updates
+	updates, err := svc.store.WatchOrder(ctx, req.OrderId)
+	if err != nil {
+		return err
+	}
+
+	// Refresh the state of the order
+	//
+	// This is performed after subscribing to updates,
+	// to ensure that no updates are missed.
+	order, err = svc.store.GetOrder(ctx, req.OrderId)
+	if err != nil {
+		return err
+	}
+
+	// Stream the order updates
+	for {
+		if err := stream.Send(&pb.WatchOrderResponse{Order: order}); err != nil {
+			return err
+		}
+
+		if IsFinalStatus(order.Status) {
+			return nil
+		}
+
+		var ok bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case order, ok = <-updates:
+			if !ok {
+				return errors.NewServiceError(errors.ErrCodeExtService, "order updates unavailable")
+			}
+		}
+	}
+}
+
 func (svc OrderService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderRequest) (*pb.PlaceOrderResponse, error) {
 	// If the request is through the gateway, then substitute req.CustomerId for current user
 	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
@@ -125,12 +213,21 @@ func (svc OrderService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderReques
 		}
 
 		req.CustomerId = claims.Subject
+
+		// use the idempotency key from the request headers (if not provided in the body)
+		if req.IdempotencyKey == nil {
+			req.IdempotencyKey = gwauth.GetGatewayIdempotencyKey(gwMd)
+		}
 	}
 
 	// Validate the request args
 	if err := svc.pbVal.Validate(req); err != nil {
 		// provide validation err context to user
 		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_CARD && req.PaymentToken == nil {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: a payment token is required when paying by card")
+	} else if req.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_GIFT_CARD && req.PaymentToken == nil {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: a gift card code is required when paying by gift card")
 	}
 
 	// Create the order.
@@ -140,30 +237,193 @@ func (svc OrderService) PlaceOrder(ctx context.Context, req *pb.PlaceOrderReques
 	order, err := svc.store.CreateOrder(
 		ctx,
 		&pb.Order{
-			Status:     pb.OrderStatus_ORDER_STATUS_PROCESSING,
-			Items:      req.Cart,
-			CustomerId: req.CustomerId,
+			Status:         pb.OrderStatus_ORDER_STATUS_PROCESSING,
+			Items:          req.Cart,
+			CustomerId:     req.CustomerId,
+			FulfilmentMode: req.FulfilmentMode,
+			CurrencyCode:   req.GetCurrencyCode(),
+			PaymentMethod:  req.PaymentMethod,
 		},
+		req.IdempotencyKey,
+		req.PaymentToken,
 	)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeUnknown, "failed to create order", err)
+		return nil, err
 	}
 
 	// Return the pending order
 	return &pb.PlaceOrderResponse{Order: order}, nil
 }
 
+func (svc OrderService) OpenReturn(ctx context.Context, req *pb.OpenReturnRequest) (*pb.OpenReturnResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// If the request is through the gateway, then ensure the user owns the order
+	if err := svc.authoriseOrderAccess(ctx, req.OrderId); err != nil {
+		return nil, err
+	}
+
+	// Open the return
+	// Dispatch ReturnRequestedEvent
+	orderReturn, err := svc.store.CreateReturn(ctx, req.OrderId, req.Items, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.OpenReturnResponse{OrderReturn: orderReturn}, nil
+}
+
+func (svc OrderService) ViewReturn(ctx context.Context, req *pb.ViewReturnRequest) (*pb.ViewReturnResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Get the return from the DB
+	orderReturn, err := svc.store.GetReturn(ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the request is through the gateway, then ensure the user owns the return
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return nil, err
+		}
+
+		if orderReturn.CustomerId != claims.Subject {
+			return nil, errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to view this return")
+		}
+	}
+
+	return &pb.ViewReturnResponse{OrderReturn: orderReturn}, nil
+}
+
+func (svc OrderService) ViewOrderReturns(ctx context.Context, req *pb.ViewOrderReturnsRequest) (*pb.ViewOrderReturnsResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// If the request is through the gateway, then ensure the user owns the order
+	if err := svc.authoriseOrderAccess(ctx, req.OrderId); err != nil {
+		return nil, err
+	}
+
+	// Get the returns from the DB
+	returns, err := svc.store.GetOrderReturns(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewOrderReturnsResponse{Returns: returns}, nil
+}
+
+func (svc OrderService) ApproveReturn(ctx context.Context, req *pb.ApproveReturnRequest) (*pb.ApproveReturnResponse, error) {
+	// Returns can only be decided upon internally (by admins)
+	if gatewayRequest, _ := gwauth.IsGatewayRequest(ctx); gatewayRequest {
+		return nil, errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to approve returns")
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Set return status to approved
+	// Dispatch ReturnApprovedEvent
+	orderReturn, err := svc.store.ApproveReturn(ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApproveReturnResponse{OrderReturn: orderReturn}, nil
+}
+
+func (svc OrderService) RejectReturn(ctx context.Context, req *pb.RejectReturnRequest) (*pb.RejectReturnResponse, error) {
+	// Returns can only be decided upon internally (by admins)
+	if gatewayRequest, _ := gwauth.IsGatewayRequest(ctx); gatewayRequest {
+		return nil, errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to reject returns")
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Set return status to rejected
+	// Dispatch ReturnRejectedEvent
+	orderReturn, err := svc.store.RejectReturn(ctx, req.ReturnId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RejectReturnResponse{OrderReturn: orderReturn}, nil
+}
+
+// If the request is through the gateway, then ensure the user owns the order.
+func (svc OrderService) authoriseOrderAccess(ctx context.Context, orderId string) error {
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if !gatewayRequest {
+		return nil
+	}
+
+	claims, err := gwauth.GetGatewayUser(gwMd)
+	if err != nil {
+		return err
+	}
+
+	order, err := svc.store.GetOrder(ctx, orderId)
+	if err != nil {
+		return err
+	}
+
+	if order.CustomerId != claims.Subject {
+		return errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to access this order")
+	}
+
+	return nil
+}
+
 func (svc OrderService) ProcessProductPriceQuoteEvent(ctx context.Context, req *eventpb.ProductPriceQuoteEvent) (*emptypb.Empty, error) {
 	if req.Type == eventpb.ProductPriceQuoteEvent_TYPE_AVAILABLE {
-		// Set order status to processing (from pending)
-		// Dispatch OrderProcessingEvent
-		_, err := svc.store.ProcessOrder(ctx, req.OrderId, req.TotalPrice)
+		// Determine the rate to convert the configured amounts (shipping, fees and discount thresholds)
+		exchangeRate, err := svc.getPricingExchangeRate(ctx, req)
+		if err != nil {
+			log.Error().Err(err).Str("order_id", req.OrderId).Msg("failed to determine pricing exchange rate")
+
+			// Set order status to rejected
+			// Dispatch OrderRejectedEvent
+			_, err := svc.store.RejectOrder(ctx, req.OrderId)
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+			}
+
+			return &emptypb.Empty{}, nil
+		}
+
+		// Calculate the price breakdown (shipping, tax and discounts) from the quote
+		priceBreakdown := PriceOrder(svc.pricingCfg, req.VariantQuantities, req.VariantPrices, req.VariantProducts, exchangeRate)
+
+		// Set order status to pending (from processing)
+		// Dispatch OrderPendingEvent
+		_, err = svc.store.ProcessOrder(ctx, req.OrderId, req.VariantPrices, priceBreakdown)
 		if err != nil {
 			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
 		}
 
 	} else if req.Type == eventpb.ProductPriceQuoteEvent_TYPE_UNAVAILABLE {
-		// Set order status to rejected (from pending)
+		// Set order status to rejected
 		// Dispatch OrderRejectedEvent
 		_, err := svc.store.RejectOrder(ctx, req.OrderId)
 		if err != nil {
@@ -177,8 +437,21 @@ func (svc OrderService) ProcessProductPriceQuoteEvent(ctx context.Context, req *
 }
 
 func (svc OrderService) ProcessStockReservationEvent(ctx context.Context, req *eventpb.StockReservationEvent) (*emptypb.Empty, error) {
-	if req.Type == eventpb.StockReservationEvent_TYPE_INSUFFICIENT_STOCK {
-		// Set order status to rejected (from processing)
+	if req.Type == eventpb.StockReservationEvent_TYPE_STOCK_RESERVED && !req.BackorderFill {
+		// Record any items that were dropped or backordered (partially fulfilled orders)
+		if len(req.InsufficientStock) > 0 || len(req.BackorderedStock) > 0 {
+			backorderedVariantIds := []string{}
+			for variantId := range req.BackorderedStock {
+				backorderedVariantIds = append(backorderedVariantIds, variantId)
+			}
+
+			err := svc.store.SetOrderItemsShortfall(ctx, req.OrderId, req.InsufficientStock, backorderedVariantIds, req.OrderMetadata.GetTotalPrice())
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+			}
+		}
+	} else if req.Type == eventpb.StockReservationEvent_TYPE_INSUFFICIENT_STOCK {
+		// Set order status to rejected
 		// Dispatch OrderRejectedEvent
 		_, err := svc.store.RejectOrder(ctx, req.OrderId)
 		if err != nil {
@@ -190,8 +463,25 @@ func (svc OrderService) ProcessStockReservationEvent(ctx context.Context, req *e
 }
 
 func (svc OrderService) ProcessShipmentAllocationEvent(ctx context.Context, req *eventpb.ShipmentAllocationEvent) (*emptypb.Empty, error) {
+	// Shipments for filled backorders do not affect the order status
+	if req.BackorderReservationId != nil {
+		if req.Type == eventpb.ShipmentAllocationEvent_TYPE_ALLOCATED {
+			variantIds := []string{}
+			for variantId := range req.VariantQuantities {
+				variantIds = append(variantIds, variantId)
+			}
+
+			err := svc.store.FillBackorderedItems(ctx, req.OrderId, variantIds)
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+			}
+		}
+
+		return &emptypb.Empty{}, nil
+	}
+
 	if req.Type == eventpb.ShipmentAllocationEvent_TYPE_FAILED {
-		// Set order status to rejected (from processing)
+		// Set order status to rejected
 		// Dispatch OrderRejectedEvent
 		_, err := svc.store.RejectOrder(ctx, req.OrderId)
 		if err != nil {
@@ -210,14 +500,14 @@ func (svc OrderService) ProcessShipmentAllocationEvent(ctx context.Context, req
 
 func (svc OrderService) ProcessPaymentProcessedEvent(ctx context.Context, req *eventpb.PaymentProcessedEvent) (*emptypb.Empty, error) {
 	if req.Type == eventpb.PaymentProcessedEvent_TYPE_SUCCESS {
-		// Set order status to approved (from processing)
+		// Set order status to approved (from pending)
 		// Dispatch OrderApprovedEvent
 		_, err := svc.store.ApproveOrder(ctx, req.OrderId, *req.TransactionId)
 		if err != nil {
 			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
 		}
 	} else if req.Type == eventpb.PaymentProcessedEvent_TYPE_FAILED {
-		// Set order status to rejected (from processing)
+		// Set order status to rejected
 		// Dispatch OrderRejectedEvent
 		_, err := svc.store.RejectOrder(ctx, req.OrderId)
 		if err != nil {
@@ -227,3 +517,73 @@ func (svc OrderService) ProcessPaymentProcessedEvent(ctx context.Context, req *e
 
 	return &emptypb.Empty{}, nil
 }
+
+func (svc OrderService) ProcessTransactionVoidedEvent(ctx context.Context, req *eventpb.TransactionVoidedEvent) (*emptypb.Empty, error) {
+	// Approved orders can no longer be dispatched once their payment hold has expired
+	// (other voids are the result of the order already being rejected or cancelled)
+	if req.Reason == eventpb.TransactionVoidedEvent_REASON_EXPIRED {
+		// Set order status to cancelled (from approved)
+		// Dispatch OrderCancelledEvent
+		_, err := svc.store.CancelOrder(ctx, req.OrderId)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc OrderService) ProcessTransactionCapturedEvent(ctx context.Context, req *eventpb.TransactionCapturedEvent) (*emptypb.Empty, error) {
+	// Only captured order payments are of interest
+	if req.OrderId == "" {
+		return &emptypb.Empty{}, nil
+	}
+
+	// The order may have already been completed
+	// (e.g. if payment was captured from multiple sources)
+	orderObj, err := svc.store.GetOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+	} else if orderObj.Status != pb.OrderStatus_ORDER_STATUS_APPROVED {
+		return &emptypb.Empty{}, nil
+	}
+
+	// Set order status to completed (from approved)
+	// Dispatch OrderCompletedEvent
+	_, err = svc.store.CompleteOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc OrderService) ProcessStockAddedEvent(ctx context.Context, req *eventpb.StockAddedEvent) (*emptypb.Empty, error) {
+	// Only stock restocked from returns is of interest
+	if req.ReturnId == nil {
+		return &emptypb.Empty{}, nil
+	}
+
+	err := svc.store.SetReturnItemRestocked(ctx, *req.ReturnId, req.VariantId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc OrderService) ProcessRefundProcessedEvent(ctx context.Context, req *eventpb.RefundProcessedEvent) (*emptypb.Empty, error) {
+	if req.Type == eventpb.RefundProcessedEvent_TYPE_SUCCESS {
+		// Complete the return (once restocked)
+		// Dispatch ReturnCompletedEvent
+		err := svc.store.SetReturnRefunded(ctx, req.ReturnId, req.GetRefundId())
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+		}
+	} else if req.Type == eventpb.RefundProcessedEvent_TYPE_FAILED {
+		// The return is left awaiting a refund (for manual intervention)
+		log.Warn().Str("return_id", req.ReturnId).Str("order_id", req.OrderId).Msg("failed to refund order return")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package order
+
+import (
+	"context"
+	"math"
+
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
+)
+
+// Price breakdown of an order
+//
+// Prices are in the minor units of the currency.
+type PriceBreakdown struct {
+	Currency string
+
+	// The rate used to convert from the pricing currency
+	ExchangeRate float64
+
+	ItemsPrice    int64
+	ShippingPrice int64
+	TaxPrice      int64
+	DiscountPrice int64
+	TotalPrice    int64
+}
+
+// A stage in the pricing pipeline.
+//
+// Each stage builds upon the breakdown produced by the previous stages.
+type pricingStage func(cfg *PricingConfig, quote *priceQuote, breakdown *PriceBreakdown)
+
+// The quoted prices of product variants in an order
+type priceQuote struct {
+	// Variant ID: Quantity
+	variantQuantities map[string]int32
+
+	// Variant ID: Unit Price
+	variantPrices map[string]*commonpb.Money
+
+	// Variant ID: Product ID
+	variantProducts map[string]string
+}
+
+// The order in which the pricing stages are applied
+var pricingPipeline = []pricingStage{
+	priceItems,
+	priceDiscount,
+	priceShipping,
+	priceTax,
+}
+
+// Calculate the price breakdown for an order from the product price quote
+//
+// The exchange rate (from the pricing currency to the quoted currency)
+// is used to convert the configured amounts, such as the shipping fee.
+func PriceOrder(cfg *PricingConfig, variantQuantities map[string]int32, variantPrices map[string]*commonpb.Money, variantProducts map[string]string, exchangeRate float64) *PriceBreakdown {
+	quote := &priceQuote{variantQuantities: variantQuantities, variantPrices: variantPrices, variantProducts: variantProducts}
+
+	if exchangeRate <= 0 {
+		exchangeRate = 1
+	}
+
+	breakdown := &PriceBreakdown{Currency: cfg.Currency, ExchangeRate: exchangeRate}
+	for _, stage := range pricingPipeline {
+		stage(cfg, quote, breakdown)
+	}
+
+	breakdown.TotalPrice = breakdown.ItemsPrice - breakdown.DiscountPrice + breakdown.ShippingPrice + breakdown.TaxPrice
+	return breakdown
+}
+
+// Get the rate used to convert from the pricing currency to the currency of a price quote.
+//
+// The rate provided with the quote is only applicable when the products
+// are priced in the same currency as the configured amounts.
+func (svc OrderService) getPricingExchangeRate(ctx context.Context, quote *eventpb.ProductPriceQuoteEvent) (float64, error) {
+	currency := quote.GetTotalPrice().GetCurrencyCode()
+	if currency == "" || currency == svc.pricingCfg.Currency {
+		return 1, nil
+	} else if quote.BaseCurrency == svc.pricingCfg.Currency && quote.ExchangeRate > 0 {
+		return quote.ExchangeRate, nil
+	}
+
+	return svc.rates.GetRate(ctx, svc.pricingCfg.Currency, currency)
+}
+
+// Sum the line totals of the quoted items
+//
+// The breakdown takes the currency of the quoted prices.
+func priceItems(cfg *PricingConfig, quote *priceQuote, breakdown *PriceBreakdown) {
+	var itemsPrice int64
+	for variantId, quantity := range quote.variantQuantities {
+		variantPrice := quote.variantPrices[variantId]
+		if variantPrice.GetCurrencyCode() != "" {
+			breakdown.Currency = variantPrice.GetCurrencyCode()
+		}
+
+		itemsPrice += variantPrice.GetMinorUnits() * int64(quantity)
+	}
+
+	breakdown.ItemsPrice = itemsPrice
+}
+
+// Apply the highest discount tier that the items price qualifies for
+func priceDiscount(cfg *PricingConfig, quote *priceQuote, breakdown *PriceBreakdown) {
+	var discountRate float64
+	for threshold, rate := range cfg.DiscountTiers {
+		if breakdown.ItemsPrice >= convertConfigured(cfg, breakdown, threshold) && rate > discountRate {
+			discountRate = rate
+		}
+	}
+
+	breakdown.DiscountPrice = money.MultiplyRate(breakdown.ItemsPrice, discountRate)
+}
+
+// Apply the shipping fee (unless the order qualifies for free shipping)
+func priceShipping(cfg *PricingConfig, quote *priceQuote, breakdown *PriceBreakdown) {
+	if cfg.FreeShippingThreshold != nil && breakdown.ItemsPrice >= convertConfigured(cfg, breakdown, *cfg.FreeShippingThreshold) {
+		breakdown.ShippingPrice = 0
+		return
+	}
+
+	breakdown.ShippingPrice = convertConfigured(cfg, breakdown, cfg.ShippingFee)
+}
+
+// Convert a configured amount from the pricing currency to the currency of the breakdown
+func convertConfigured(cfg *PricingConfig, breakdown *PriceBreakdown, amount int64) int64 {
+	if breakdown.Currency == cfg.Currency {
+		return amount
+	}
+
+	return money.Convert(amount, cfg.Currency, breakdown.Currency, breakdown.ExchangeRate)
+}
+
+// Calculate tax on each item using the tax rate table
+//
+// Tax rates are configured per product (applying to all variants of the product).
+// Tax is calculated after the discount has been applied to the items.
+// The total is rounded (rather than each item) to avoid accumulating rounding errors.
+func priceTax(cfg *PricingConfig, quote *priceQuote, breakdown *PriceBreakdown) {
+	var discountMultiplier float64 = 1
+	if breakdown.ItemsPrice > 0 {
+		discountMultiplier = float64(breakdown.ItemsPrice-breakdown.DiscountPrice) / float64(breakdown.ItemsPrice)
+	}
+
+	var taxPrice float64
+	for variantId, quantity := range quote.variantQuantities {
+		rate, ok := cfg.TaxRates[quote.variantProducts[variantId]]
+		if !ok {
+			rate = cfg.TaxRates["default"]
+		}
+
+		lineTotal := quote.variantPrices[variantId].GetMinorUnits() * int64(quantity)
+		taxPrice += float64(lineTotal) * discountMultiplier * rate
+	}
+
+	breakdown.TaxPrice = int64(math.Round(taxPrice))
+}
+
+// Calculate the refund for each item of an order return.
+//
+// Items are refunded at their share of the amount charged for them when the order
+// was placed (the line total, less its share of the discount, plus its share of the tax),
+// proportional to the quantity being returned.
+//
+// The discount and tax of the order are shared between the items in proportion to their line totals.
+func PriceReturnItems(orderObj *pb.Order, returnQuantities map[string]int32) (map[string]*commonpb.Money, *commonpb.Money) {
+	currency := orderObj.TotalPrice.GetCurrencyCode()
+	itemsPrice := orderObj.ItemsPrice.GetMinorUnits()
+
+	itemRefunds := make(map[string]*commonpb.Money)
+	var refundAmount int64
+	for variantId, quantity := range returnQuantities {
+		itemPrice, priced := orderObj.ItemPrices[variantId]
+		orderedQuantity := orderObj.Items[variantId]
+		if !priced || orderedQuantity == 0 {
+			continue
+		}
+
+		lineTotal := itemPrice.LineTotal.GetMinorUnits()
+		chargedTotal := lineTotal
+		if itemsPrice > 0 {
+			chargedTotal = lineTotal - money.Prorate(orderObj.DiscountPrice.GetMinorUnits(), lineTotal, itemsPrice) + money.Prorate(orderObj.TaxPrice.GetMinorUnits(), lineTotal, itemsPrice)
+		}
+
+		itemRefund := money.Prorate(chargedTotal, int64(quantity), int64(orderedQuantity))
+		itemRefunds[variantId] = money.New(itemRefund, currency)
+		refundAmount += itemRefund
+	}
+
+	return itemRefunds, money.New(refundAmount, currency)
+}
@@ -0,0 +1,301 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package order
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
+)
+
+func TestPriceOrder(t *testing.T) {
+	freeShippingThreshold := int64(5000)
+
+	baseCfg := PricingConfig{
+		Currency:    "USD",
+		ShippingFee: 499,
+		TaxRates:    map[string]float64{"default": 0.2, "reduced": 0.05},
+	}
+
+	tests := []struct {
+		name string
+
+		cfg          PricingConfig
+		quantities   map[string]int32
+		prices       map[string]*commonpb.Money
+		products     map[string]string
+		exchangeRate float64
+
+		want PriceBreakdown
+	}{
+		{
+			name:         "items with shipping and default tax",
+			cfg:          baseCfg,
+			quantities:   map[string]int32{"v1": 2, "v2": 1},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "USD"), "v2": money.New(500, "USD")},
+			products:     map[string]string{"v1": "p1", "v2": "p2"},
+			exchangeRate: 1,
+			want: PriceBreakdown{
+				Currency:      "USD",
+				ExchangeRate:  1,
+				ItemsPrice:    2500,
+				ShippingPrice: 499,
+				TaxPrice:      500,
+				TotalPrice:    3499,
+			},
+		},
+		{
+			name:         "product specific tax rate",
+			cfg:          baseCfg,
+			quantities:   map[string]int32{"v1": 2, "v2": 1},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "USD"), "v2": money.New(500, "USD")},
+			products:     map[string]string{"v1": "p1", "v2": "reduced"},
+			exchangeRate: 1,
+			want: PriceBreakdown{
+				Currency:      "USD",
+				ExchangeRate:  1,
+				ItemsPrice:    2500,
+				ShippingPrice: 499,
+				TaxPrice:      425,
+				TotalPrice:    3424,
+			},
+		},
+		{
+			name: "free shipping threshold met",
+			cfg: PricingConfig{
+				Currency:              "USD",
+				ShippingFee:           499,
+				FreeShippingThreshold: &freeShippingThreshold,
+				TaxRates:              map[string]float64{"default": 0.2},
+			},
+			quantities:   map[string]int32{"v1": 5},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "USD")},
+			products:     map[string]string{"v1": "p1"},
+			exchangeRate: 1,
+			want: PriceBreakdown{
+				Currency:      "USD",
+				ExchangeRate:  1,
+				ItemsPrice:    5000,
+				ShippingPrice: 0,
+				TaxPrice:      1000,
+				TotalPrice:    6000,
+			},
+		},
+		{
+			name: "highest applicable discount tier with tax after discount",
+			cfg: PricingConfig{
+				Currency:      "USD",
+				ShippingFee:   499,
+				TaxRates:      map[string]float64{"default": 0.2},
+				DiscountTiers: map[int64]float64{2000: 0.05, 2500: 0.1, 10000: 0.2},
+			},
+			quantities:   map[string]int32{"v1": 2, "v2": 1},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "USD"), "v2": money.New(500, "USD")},
+			products:     map[string]string{"v1": "p1", "v2": "p2"},
+			exchangeRate: 1,
+			want: PriceBreakdown{
+				Currency:      "USD",
+				ExchangeRate:  1,
+				ItemsPrice:    2500,
+				DiscountPrice: 250,
+				ShippingPrice: 499,
+				TaxPrice:      450,
+				TotalPrice:    3199,
+			},
+		},
+		{
+			name: "configured amounts converted to the quoted currency",
+			cfg: PricingConfig{
+				Currency:              "USD",
+				ShippingFee:           499,
+				FreeShippingThreshold: &freeShippingThreshold,
+				TaxRates:              map[string]float64{"default": 0.2},
+			},
+			quantities:   map[string]int32{"v1": 2},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "EUR")},
+			products:     map[string]string{"v1": "p1"},
+			exchangeRate: 0.5,
+			want: PriceBreakdown{
+				Currency:      "EUR",
+				ExchangeRate:  0.5,
+				ItemsPrice:    2000,
+				ShippingPrice: 250,
+				TaxPrice:      400,
+				TotalPrice:    2650,
+			},
+		},
+		{
+			name: "free shipping threshold converted to the quoted currency",
+			cfg: PricingConfig{
+				Currency:              "USD",
+				ShippingFee:           499,
+				FreeShippingThreshold: &freeShippingThreshold,
+				TaxRates:              map[string]float64{"default": 0.2},
+			},
+			quantities:   map[string]int32{"v1": 3},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "EUR")},
+			products:     map[string]string{"v1": "p1"},
+			exchangeRate: 0.5,
+			want: PriceBreakdown{
+				Currency:      "EUR",
+				ExchangeRate:  0.5,
+				ItemsPrice:    3000,
+				ShippingPrice: 0,
+				TaxPrice:      600,
+				TotalPrice:    3600,
+			},
+		},
+		{
+			name:         "conversion to a currency without minor units",
+			cfg:          baseCfg,
+			quantities:   map[string]int32{"v1": 1},
+			prices:       map[string]*commonpb.Money{"v1": money.New(3000, "JPY")},
+			products:     map[string]string{"v1": "p1"},
+			exchangeRate: 150,
+			want: PriceBreakdown{
+				Currency:      "JPY",
+				ExchangeRate:  150,
+				ItemsPrice:    3000,
+				ShippingPrice: 749,
+				TaxPrice:      600,
+				TotalPrice:    4349,
+			},
+		},
+		{
+			name:         "missing exchange rate defaults to one",
+			cfg:          baseCfg,
+			quantities:   map[string]int32{"v1": 1},
+			prices:       map[string]*commonpb.Money{"v1": money.New(1000, "USD")},
+			products:     map[string]string{"v1": "p1"},
+			exchangeRate: 0,
+			want: PriceBreakdown{
+				Currency:      "USD",
+				ExchangeRate:  1,
+				ItemsPrice:    1000,
+				ShippingPrice: 499,
+				TaxPrice:      200,
+				TotalPrice:    1699,
+			},
+		},
+		{
+			name:         "empty order",
+			cfg:          baseCfg,
+			quantities:   map[string]int32{},
+			prices:       map[string]*commonpb.Money{},
+			products:     map[string]string{},
+			exchangeRate: 1,
+			want: PriceBreakdown{
+				Currency:      "USD",
+				ExchangeRate:  1,
+				ShippingPrice: 499,
+				TotalPrice:    499,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := PriceOrder(&tt.cfg, tt.quantities, tt.prices, tt.products, tt.exchangeRate)
+			if *got != tt.want {
+				t.Errorf("PriceOrder() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPriceReturnItems(t *testing.T) {
+	// 2 x v1 at 10.00 and 1 x v2 at 5.00, with a 10% discount and 20% tax
+	// (charged 21.60 for v1 and 5.40 for v2)
+	discountedOrder := &pb.Order{
+		Items: map[string]int32{"v1": 2, "v2": 1},
+		ItemPrices: map[string]*pb.OrderItemPrice{
+			"v1": {UnitPrice: money.New(1000, "USD"), LineTotal: money.New(2000, "USD")},
+			"v2": {UnitPrice: money.New(500, "USD"), LineTotal: money.New(500, "USD")},
+		},
+		ItemsPrice:    money.New(2500, "USD"),
+		DiscountPrice: money.New(250, "USD"),
+		ShippingPrice: money.New(499, "USD"),
+		TaxPrice:      money.New(450, "USD"),
+		TotalPrice:    money.New(3199, "USD"),
+	}
+
+	undiscountedOrder := &pb.Order{
+		Items: map[string]int32{"v1": 3},
+		ItemPrices: map[string]*pb.OrderItemPrice{
+			"v1": {UnitPrice: money.New(333, "EUR"), LineTotal: money.New(999, "EUR")},
+		},
+		ItemsPrice:    money.New(999, "EUR"),
+		ShippingPrice: money.New(250, "EUR"),
+		TotalPrice:    money.New(1249, "EUR"),
+	}
+
+	tests := map[string]struct {
+		order      *pb.Order
+		quantities map[string]int32
+
+		wantItems map[string]int64
+		wantTotal *commonpb.Money
+	}{
+		"part of a discounted and taxed line": {
+			order:      discountedOrder,
+			quantities: map[string]int32{"v1": 1},
+			wantItems:  map[string]int64{"v1": 1080},
+			wantTotal:  money.New(1080, "USD"),
+		},
+		"every item of a discounted and taxed order (excluding shipping)": {
+			order:      discountedOrder,
+			quantities: map[string]int32{"v1": 2, "v2": 1},
+			wantItems:  map[string]int64{"v1": 2160, "v2": 540},
+			wantTotal:  money.New(2700, "USD"),
+		},
+		"order without discount or tax": {
+			order:      undiscountedOrder,
+			quantities: map[string]int32{"v1": 2},
+			wantItems:  map[string]int64{"v1": 666},
+			wantTotal:  money.New(666, "EUR"),
+		},
+		"items without a quoted price": {
+			order:      discountedOrder,
+			quantities: map[string]int32{"v2": 1, "v3": 1},
+			wantItems:  map[string]int64{"v2": 540},
+			wantTotal:  money.New(540, "USD"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			itemRefunds, total := PriceReturnItems(tt.order, tt.quantities)
+			if !proto.Equal(total, tt.wantTotal) {
+				t.Errorf("PriceReturnItems() total = %v, want %v", total, tt.wantTotal)
+			}
+
+			if len(itemRefunds) != len(tt.wantItems) {
+				t.Errorf("PriceReturnItems() refunded %d items, want %d", len(itemRefunds), len(tt.wantItems))
+			}
+
+			for variantId, want := range tt.wantItems {
+				got := itemRefunds[variantId]
+				if got.GetMinorUnits() != want || got.GetCurrencyCode() != tt.wantTotal.GetCurrencyCode() {
+					t.Errorf("PriceReturnItems() %s = %v, want %d %s", variantId, got, want, tt.wantTotal.GetCurrencyCode())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package order
+
+import (
+	"slices"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/order/v1"
+)
+
+// The order state machine.
+//
+// Maps each order status to the statuses that an order is permitted to transition to.
+// Statuses without any transitions (rejected, completed and cancelled) are final.
+var orderStatusTransitions = map[pb.OrderStatus][]pb.OrderStatus{
+	// awaiting price quotes
+	pb.OrderStatus_ORDER_STATUS_PROCESSING: {
+		pb.OrderStatus_ORDER_STATUS_PENDING,
+		pb.OrderStatus_ORDER_STATUS_REJECTED,
+	},
+
+	// awaiting stock allocation, shipping allotment and payment
+	pb.OrderStatus_ORDER_STATUS_PENDING: {
+		pb.OrderStatus_ORDER_STATUS_APPROVED,
+		pb.OrderStatus_ORDER_STATUS_REJECTED,
+	},
+
+	// awaiting dispatch (and capture of the payment)
+	pb.OrderStatus_ORDER_STATUS_APPROVED: {
+		pb.OrderStatus_ORDER_STATUS_COMPLETED,
+		pb.OrderStatus_ORDER_STATUS_CANCELLED,
+	},
+}
+
+// Determine if an order status is final (with no further transitions).
+func IsFinalStatus(status pb.OrderStatus) bool {
+	return len(orderStatusTransitions[status]) == 0
+}
+
+// Ensure that an order is permitted to transition between two statuses.
+func ValidateStatusTransition(from pb.OrderStatus, to pb.OrderStatus) error {
+	if !slices.Contains(orderStatusTransitions[from], to) {
+		return errors.NewServiceErrorf(errors.ErrCodeInvalidState, "order cannot transition from %s to %s", from.String(), to.String())
+	}
+
+	return nil
+}
+
+// The order return state machine.
+//
+// Maps each return status to the statuses that a return is permitted to transition to.
+var returnStatusTransitions = map[pb.ReturnStatus][]pb.ReturnStatus{
+	// awaiting approval
+	pb.ReturnStatus_RETURN_STATUS_REQUESTED: {
+		pb.ReturnStatus_RETURN_STATUS_APPROVED,
+		pb.ReturnStatus_RETURN_STATUS_REJECTED,
+	},
+
+	// awaiting restock and refund
+	pb.ReturnStatus_RETURN_STATUS_APPROVED: {
+		pb.ReturnStatus_RETURN_STATUS_COMPLETED,
+	},
+}
+
+// Ensure that an order return is permitted to transition between two statuses.
+func ValidateReturnStatusTransition(from pb.ReturnStatus, to pb.ReturnStatus) error {
+	if !slices.Contains(returnStatusTransitions[from], to) {
+		return errors.NewServiceErrorf(errors.ErrCodeInvalidState, "return cannot transition from %s to %s", from.String(), to.String())
+	}
+
+	return nil
+}
+
+// Determine if a return can be opened against an order with a given status.
+//
+// Returns can only be opened once an order has been completed (its shipment
+// dispatched and payment captured), as payment is only held for approved orders.
+func IsReturnableStatus(status pb.OrderStatus) bool {
+	return status == pb.OrderStatus_ORDER_STATUS_COMPLETED
+}
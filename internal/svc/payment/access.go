@@ -0,0 +1,115 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package payment
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
+)
+
+// Ensure that the caller is permitted to access a customer's payment information.
+//
+// Gateway users can only access their own information, unless their token grants
+// an elevated (admin or service) role. Requests from within the internal network
+// (not through the gateway) are trusted.
+//
+// All attempts are recorded in the audit log.
+func authorizeCustomerAccess(ctx context.Context, action string, customerId string, resourceId string) error {
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if !gatewayRequest {
+		auditAccess(action, customerId, resourceId, nil, true)
+		return nil
+	}
+
+	// Ensure the user is authenticated
+	claims, err := gwauth.GetGatewayUser(gwMd)
+	if err != nil {
+		auditAccess(action, customerId, resourceId, nil, false)
+		return err
+	}
+
+	allowed := claims.CanAccessCustomer(customerId)
+	auditAccess(action, customerId, resourceId, claims, allowed)
+	if !allowed {
+		return errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to "+action)
+	}
+
+	return nil
+}
+
+// Ensure that the caller is permitted to access a resource belonging to a customer.
+//
+// Callers that are not permitted to access the resource are told that it was not
+// found, so that they cannot discover which resource ids belong to other customers.
+func authorizeResourceAccess(ctx context.Context, action string, resourceName string, customerId string, resourceId string) error {
+	if err := authorizeCustomerAccess(ctx, action, customerId, resourceId); err != nil {
+		return errors.NewServiceError(errors.ErrCodeNotFound, resourceName+" not found")
+	}
+
+	return nil
+}
+
+// Ensure that the caller is permitted to perform an administrative action.
+//
+// Gateway users must hold an elevated (admin or service) role. Requests from
+// within the internal network (not through the gateway) are trusted.
+//
+// All attempts are recorded in the audit log.
+func authorizeElevatedAccess(ctx context.Context, action string, customerId string, resourceId string) error {
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if !gatewayRequest {
+		auditAccess(action, customerId, resourceId, nil, true)
+		return nil
+	}
+
+	// Ensure the user is authenticated
+	claims, err := gwauth.GetGatewayUser(gwMd)
+	if err != nil {
+		auditAccess(action, customerId, resourceId, nil, false)
+		return err
+	}
+
+	allowed := claims.HasElevatedAccess()
+	auditAccess(action, customerId, resourceId, claims, allowed)
+	if !allowed {
+		return errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to "+action)
+	}
+
+	return nil
+}
+
+// Record an attempt to access a customer's payment information.
+//
+// Claims are nil for internal (or unauthenticated) requests.
+func auditAccess(action string, customerId string, resourceId string, claims *gwauth.JWTClaims, allowed bool) {
+	event := log.Info()
+	if !allowed {
+		event = log.Warn()
+	}
+
+	event = event.Str("action", action).Str("customer_id", customerId).Str("resource_id", resourceId).Bool("allowed", allowed)
+	if claims != nil {
+		event = event.Str("subject", claims.Subject).Str("role", claims.Role)
+	} else {
+		event = event.Bool("authenticated", false)
+	}
+
+	event.Msg("payment access audit")
+}
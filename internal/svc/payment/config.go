@@ -16,17 +16,23 @@
 package payment
 
 import (
+	"strconv"
+	"time"
+
 	"github.com/hexolan/stocklet/internal/pkg/config"
+	"github.com/hexolan/stocklet/internal/pkg/errors"
 )
 
 // Order Service Configuration
 type ServiceConfig struct {
 	// Core Configuration
-	Shared config.SharedConfig
+	Shared      config.SharedConfig
+	ServiceOpts ServiceConfigOpts
 
 	// Dynamically loaded configuration
 	Postgres config.PostgresConfig
 	Kafka    config.KafkaConfig
+	FX       config.FXConfig
 }
 
 // load the base service configuration
@@ -38,5 +44,97 @@ func NewServiceConfig() (*ServiceConfig, error) {
 		return nil, err
 	}
 
+	// load the service config opts
+	if err := cfg.ServiceOpts.Load(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// Service specific config options
+type ServiceConfigOpts struct {
+	// Env Var: "AUTHORIZATION_EXPIRY" (optional)
+	// Period after which uncaptured payment authorizations are voided (e.g. "72h")
+	// Defaults to 7 days
+	AuthorizationExpiry time.Duration
+
+	// Env Var: "GIFT_CARD_VALIDITY" (optional)
+	// Period for which issued gift cards remain valid, unless otherwise specified (e.g. "8760h")
+	// Defaults to 365 days
+	GiftCardValidity time.Duration
+
+	// Env Var: "RISK_RULES_FILE" (optional)
+	// Path to the file declaring the payment risk rules
+	// If not set, then payments are not subject to any risk rules
+	RiskRulesFile string
+
+	// Env Var: "FUNDING_SOURCE" (optional)
+	// The source charged when customers top up their balance (currently only "fakecard")
+	// Defaults to "fakecard"
+	FundingSource string
+
+	// Env Var: "FAKECARD_APPROVAL_RATE" (optional)
+	// Proportion of charges approved by the fake card processor (between 0 and 1)
+	// Defaults to 1
+	FakeCardApprovalRate float64
+
+	// Env Var: "FAKECARD_LATENCY" (optional)
+	// Simulated processing time of the fake card processor (e.g. "250ms")
+	// Defaults to 0
+	FakeCardLatency time.Duration
+}
+
+// Load the ServiceConfigOpts
+func (opts *ServiceConfigOpts) Load() error {
+	opts.AuthorizationExpiry = time.Hour * 24 * 7
+	if authorizationExpiry, err := config.RequireFromEnv("AUTHORIZATION_EXPIRY"); err == nil {
+		value, err := time.ParseDuration(authorizationExpiry)
+		if err != nil || value <= 0 {
+			return errors.NewServiceError(errors.ErrCodeService, "invalid cfg option (AUTHORIZATION_EXPIRY)")
+		}
+
+		opts.AuthorizationExpiry = value
+	}
+
+	opts.GiftCardValidity = time.Hour * 24 * 365
+	if giftCardValidity, err := config.RequireFromEnv("GIFT_CARD_VALIDITY"); err == nil {
+		value, err := time.ParseDuration(giftCardValidity)
+		if err != nil || value <= 0 {
+			return errors.NewServiceError(errors.ErrCodeService, "invalid cfg option (GIFT_CARD_VALIDITY)")
+		}
+
+		opts.GiftCardValidity = value
+	}
+
+	if riskRulesFile, err := config.RequireFromEnv("RISK_RULES_FILE"); err == nil {
+		opts.RiskRulesFile = riskRulesFile
+	}
+
+	if fundingSource, err := config.RequireFromEnv("FUNDING_SOURCE"); err == nil {
+		opts.FundingSource = fundingSource
+	} else {
+		opts.FundingSource = "fakecard"
+	}
+
+	opts.FakeCardApprovalRate = 1
+	if approvalRate, err := config.RequireFromEnv("FAKECARD_APPROVAL_RATE"); err == nil {
+		value, err := strconv.ParseFloat(approvalRate, 64)
+		if err != nil || value < 0 || value > 1 {
+			return errors.NewServiceError(errors.ErrCodeService, "invalid cfg option (FAKECARD_APPROVAL_RATE)")
+		}
+
+		opts.FakeCardApprovalRate = value
+	}
+
+	if latency, err := config.RequireFromEnv("FAKECARD_LATENCY"); err == nil {
+		value, err := time.ParseDuration(latency)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "invalid cfg option (FAKECARD_LATENCY)", err)
+		}
+
+		opts.FakeCardLatency = value
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	mathrand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	"github.com/hexolan/stocklet/internal/svc/payment"
+)
+
+// Payment tokens that the fake card processor will always decline.
+//
+// Other tokens are approved (at the configured approval rate).
+const (
+	FakeCardTokenDeclined          string = "tok_declined"
+	FakeCardTokenInsufficientFunds string = "tok_insufficient_funds"
+)
+
+// A stand-in card processor for local use.
+//
+// Charges and authorizations are held in memory and never leave the service.
+// The approval rate and processing latency can be configured
+// to simulate the behaviour of an external processor.
+type fakeCardProcessor struct {
+	serviceOpts *payment.ServiceConfigOpts
+
+	mu             *sync.Mutex
+	charges        map[string]*commonpb.Money
+	authorizations map[string]*commonpb.Money
+}
+
+func NewFakeCardProcessor(serviceOpts *payment.ServiceConfigOpts) payment.FundingSource {
+	return fakeCardProcessor{
+		serviceOpts:    serviceOpts,
+		mu:             &sync.Mutex{},
+		charges:        make(map[string]*commonpb.Money),
+		authorizations: make(map[string]*commonpb.Money),
+	}
+}
+
+// Declined charges result in an invalid state error.
+func (p fakeCardProcessor) Charge(ctx context.Context, customerId string, amount *commonpb.Money, paymentToken string) (string, error) {
+	reference, err := p.process(ctx, paymentToken, "ch_")
+	if err != nil {
+		return "", err
+	}
+
+	p.mu.Lock()
+	p.charges[reference] = amount
+	p.mu.Unlock()
+
+	log.Info().Str("customer_id", customerId).Str("reference", reference).Int64("amount", amount.GetMinorUnits()).Str("currency", amount.GetCurrencyCode()).Msg("fake card charged")
+	return reference, nil
+}
+
+// Declined authorizations result in an invalid state error.
+func (p fakeCardProcessor) Authorize(ctx context.Context, customerId string, amount *commonpb.Money, paymentToken string) (string, error) {
+	reference, err := p.process(ctx, paymentToken, "auth_")
+	if err != nil {
+		return "", err
+	}
+
+	p.mu.Lock()
+	p.authorizations[reference] = amount
+	p.mu.Unlock()
+
+	log.Info().Str("customer_id", customerId).Str("reference", reference).Int64("amount", amount.GetMinorUnits()).Str("currency", amount.GetCurrencyCode()).Msg("fake card authorized")
+	return reference, nil
+}
+
+func (p fakeCardProcessor) Capture(ctx context.Context, reference string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	amount, ok := p.authorizations[reference]
+	if !ok {
+		return errors.NewServiceError(errors.ErrCodeNotFound, "authorization not found")
+	}
+
+	delete(p.authorizations, reference)
+	p.charges[reference] = amount
+	log.Info().Str("reference", reference).Msg("fake card authorization captured")
+	return nil
+}
+
+// Simulate the processing of a payment token.
+//
+// Returns a reference (with the given prefix) if approved.
+func (p fakeCardProcessor) process(ctx context.Context, paymentToken string, refPrefix string) (string, error) {
+	// Simulate the processing time
+	if p.serviceOpts.FakeCardLatency > 0 {
+		select {
+		case <-time.After(p.serviceOpts.FakeCardLatency):
+		case <-ctx.Done():
+			return "", errors.WrapServiceError(errors.ErrCodeExtService, "card processor timed out", ctx.Err())
+		}
+	}
+
+	switch paymentToken {
+	case FakeCardTokenDeclined:
+		return "", errors.NewServiceError(errors.ErrCodeInvalidState, "card declined")
+	case FakeCardTokenInsufficientFunds:
+		return "", errors.NewServiceError(errors.ErrCodeInvalidState, "card declined: insufficient funds")
+	}
+
+	if mathrand.Float64() >= p.serviceOpts.FakeCardApprovalRate {
+		return "", errors.NewServiceError(errors.ErrCodeInvalidState, "card declined")
+	}
+
+	// Generate a reference
+	refBytes := make([]byte, 12)
+	if _, err := rand.Read(refBytes); err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeService, "failed to generate reference", err)
+	}
+
+	return refPrefix + hex.EncodeToString(refBytes), nil
+}
+
+func (p fakeCardProcessor) Void(ctx context.Context, reference string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, ok := p.authorizations[reference]; ok {
+		delete(p.authorizations, reference)
+		log.Info().Str("reference", reference).Msg("fake card authorization voided")
+		return nil
+	}
+
+	if _, ok := p.charges[reference]; !ok {
+		return errors.NewServiceError(errors.ErrCodeNotFound, "charge not found")
+	}
+
+	delete(p.charges, reference)
+	log.Info().Str("reference", reference).Msg("fake card charge voided")
+	return nil
+}
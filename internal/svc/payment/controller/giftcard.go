@@ -0,0 +1,516 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/fx"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+	"github.com/hexolan/stocklet/internal/svc/payment"
+)
+
+const pgGiftCardBaseQuery string = "SELECT id, code, initial_value, remaining_value, currency, status, customer_id, issued_at, expires_at FROM gift_cards"
+
+// Characters used in gift card codes (excluding those easily mistaken for one another)
+const giftCardCodeAlphabet string = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// Gift cards hold value outside of customer balances, which is tracked in the
+// ledger under the "giftcards" system account. Issuing a gift card credits the
+// account, redemptions move value from the account into customer balances and
+// any value remaining on expired gift cards is moved to the "giftcard_breakage"
+// account.
+
+func (c postgresController) IssueGiftCard(ctx context.Context, value *commonpb.Money, expiresAt *int64) (*pb.GiftCard, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Determine when the gift card expires
+	expiry := time.Now().UTC().Add(c.serviceOpts.GiftCardValidity)
+	if expiresAt != nil {
+		expiry = time.Unix(*expiresAt, 0).UTC()
+	}
+
+	code, err := generateGiftCardCode()
+	if err != nil {
+		return nil, err
+	}
+
+	// Insert the gift card
+	var giftCardId string
+	err = tx.QueryRow(
+		ctx,
+		"INSERT INTO gift_cards (code, initial_value, remaining_value, currency, status, expires_at) VALUES ($1, $2, $2, $3, $4, $5) RETURNING id",
+		code,
+		value.GetMinorUnits(),
+		value.GetCurrencyCode(),
+		pb.GiftCardStatus_GIFT_CARD_STATUS_ACTIVE,
+		expiry,
+	).Scan(&giftCardId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert gift card", err)
+	}
+
+	giftCard, err := c.getGiftCard(ctx, tx, "id", giftCardId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the issued value in the ledger
+	_, err = c.postLedgerEntry(ctx, tx, systemAccount("giftcard_sales", value.GetCurrencyCode()), systemAccount("giftcards", value.GetCurrencyCode()), value, nil, "gift card issued")
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareGiftCardIssuedEvent(giftCard)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", giftCard.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return giftCard, nil
+}
+
+func (c postgresController) GetGiftCard(ctx context.Context, code string) (*pb.GiftCard, error) {
+	row := c.cl.QueryRow(ctx, pgGiftCardBaseQuery+" WHERE code=$1", normaliseGiftCardCode(code))
+	return scanRowToGiftCard(row)
+}
+
+// Get a gift card by a column (locking the row until the transaction has completed).
+func (c postgresController) getGiftCard(ctx context.Context, tx pgx.Tx, column string, value string) (*pb.GiftCard, error) {
+	row := tx.QueryRow(ctx, pgGiftCardBaseQuery+" WHERE "+column+"=$1 FOR UPDATE", value)
+	return scanRowToGiftCard(row)
+}
+
+// Redeem the remaining value of a gift card into a customer's balance.
+func (c postgresController) RedeemGiftCard(ctx context.Context, code string, customerId string) (*pb.GiftCard, *pb.CustomerBalance, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	giftCard, balance, err := c.redeemGiftCard(ctx, tx, "code", normaliseGiftCardCode(code), customerId, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return giftCard, balance, nil
+}
+
+// Pay for an order with a gift card, with any remainder paid from the customer's balance.
+//
+// The value required from the gift card is redeemed into the customer's balance,
+// from which the full amount is then held for the order.
+// Dispatches a PaymentProcessedEvent with the outcome.
+func (c postgresController) AuthorizeGiftCardPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, giftCardId string, velocityRules []*payment.RiskRule) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ensure the payment is within the velocity limits
+	riskReason, err := c.checkVelocityRules(ctx, tx, customerId, velocityRules)
+	if err != nil {
+		return err
+	}
+
+	var transaction *pb.Transaction
+	if riskReason == commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+		// Attempt the payment within a savepoint
+		// - so that a partial redemption is undone if the remainder could not be held
+		transaction, err = c.authorizeGiftCardPayment(ctx, tx, orderId, customerId, amount, giftCardId)
+		if err != nil {
+			// check that the error is a result of the payment being declined
+			// (e.g. an invalid gift card or insufficient balance)
+			svcErr, ok := err.(*errors.ServiceError)
+			if !ok || svcErr.Code() == errors.ErrCodeExtService || svcErr.Code() == errors.ErrCodeService {
+				return err
+			}
+		}
+	}
+
+	// Prepare response event
+	var (
+		evt      []byte
+		evtTopic string
+	)
+	if transaction != nil {
+		// Successful
+		evt, evtTopic, err = payment.PreparePaymentProcessedEvent_Success(transaction)
+	} else {
+		// Failure
+		evt, evtTopic, err = payment.PreparePaymentProcessedEvent_Failure(orderId, customerId, amount, commonpb.PaymentMethod_PAYMENT_METHOD_GIFT_CARD, riskReason)
+	}
+
+	// Ensure the event was prepared successfully
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	// Add the event to the outbox table
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderId, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+func (c postgresController) authorizeGiftCardPayment(ctx context.Context, tx pgx.Tx, orderId string, customerId string, amount *commonpb.Money, giftCardId string) (*pb.Transaction, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer savepoint.Rollback(ctx)
+
+	// Determine the amount required (in the currency of the balance)
+	balance, err := c.getBalance(ctx, &savepoint, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	required, _, err := fx.Convert(ctx, c.rates, amount, balance.Balance.GetCurrencyCode())
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeInvalidState, "failed to convert currency", err)
+	}
+
+	// Redeem the required value (or as much as remains) from the gift card
+	_, _, err = c.redeemGiftCard(ctx, savepoint, "id", giftCardId, customerId, required, &orderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hold the full amount from the balance
+	transaction, err := c.debitBalance(ctx, &savepoint, customerId, amount, &orderId, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Release the savepoint
+	err = savepoint.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return transaction, nil
+}
+
+// Redeem value from a gift card (located by a column) into a customer's balance.
+//
+// If limit is nil, then the full remaining value is redeemed.
+// Otherwise, up to the limit (in the currency of the gift card) is redeemed.
+//
+// The gift card is tied to the customer once first redeemed.
+func (c postgresController) redeemGiftCard(ctx context.Context, tx pgx.Tx, column string, lookup string, customerId string, limit *commonpb.Money, orderId *string) (*pb.GiftCard, *pb.CustomerBalance, error) {
+	giftCard, err := c.getGiftCard(ctx, tx, column, lookup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Ensure the gift card can be redeemed by the customer
+	if giftCard.Status == pb.GiftCardStatus_GIFT_CARD_STATUS_REDEEMED {
+		return nil, nil, errors.NewServiceError(errors.ErrCodeInvalidState, "gift card has already been redeemed")
+	} else if giftCard.Status != pb.GiftCardStatus_GIFT_CARD_STATUS_ACTIVE || giftCard.ExpiresAt <= time.Now().Unix() {
+		return nil, nil, errors.NewServiceError(errors.ErrCodeInvalidState, "gift card has expired")
+	} else if giftCard.CustomerId != nil && *giftCard.CustomerId != customerId {
+		return nil, nil, errors.NewServiceError(errors.ErrCodeForbidden, "gift card has been redeemed by another customer")
+	}
+
+	balance, err := c.getBalance(ctx, &tx, customerId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currency := giftCard.RemainingValue.GetCurrencyCode()
+	if balance.Balance.GetCurrencyCode() != currency {
+		return nil, nil, errors.NewServiceError(errors.ErrCodeInvalidState, "gift card currency does not match the balance")
+	}
+
+	// Determine the value to redeem
+	value := giftCard.RemainingValue.GetMinorUnits()
+	if limit != nil && limit.GetMinorUnits() < value {
+		value = limit.GetMinorUnits()
+	}
+
+	if value <= 0 {
+		return nil, nil, errors.NewServiceError(errors.ErrCodeInvalidState, "gift card has no remaining value")
+	}
+
+	// Update the gift card
+	_, err = tx.Exec(
+		ctx,
+		"UPDATE gift_cards SET remaining_value = remaining_value - $1, customer_id = $2, status = CASE WHEN remaining_value - $1 = 0 THEN $3 ELSE status END WHERE id=$4",
+		value,
+		customerId,
+		pb.GiftCardStatus_GIFT_CARD_STATUS_REDEEMED,
+		giftCard.Id,
+	)
+	if err != nil {
+		return nil, nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update gift card", err)
+	}
+
+	giftCard, err = c.getGiftCard(ctx, tx, "id", giftCard.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Credit the redeemed value to the balance
+	// Dispatch BalanceCreditedEvent
+	amount := money.New(value, currency)
+	balance, err = c.creditBalance(ctx, &tx, customerId, amount, &payment.BalanceCredit{Source: eventpb.BalanceCreditedEvent_SOURCE_GIFT_CARD, Reference: &giftCard.Id, OrderId: orderId})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareGiftCardRedeemedEvent(giftCard, customerId, amount, orderId)
+	if err != nil {
+		return nil, nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", giftCard.Id, evtTopic, evt)
+	if err != nil {
+		return nil, nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return giftCard, balance, nil
+}
+
+// Redeem the value remaining on the gift cards tied to a customer.
+func (c postgresController) redeemCustomerGiftCards(ctx context.Context, tx pgx.Tx, customerId string) error {
+	rows, err := tx.Query(
+		ctx,
+		"SELECT id FROM gift_cards WHERE customer_id=$1 AND status=$2 AND remaining_value > 0 AND expires_at > timezone('utc', now())",
+		customerId,
+		pb.GiftCardStatus_GIFT_CARD_STATUS_ACTIVE,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching gift cards", err)
+	}
+
+	giftCardIds, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning gift card rows", err)
+	}
+
+	for _, giftCardId := range giftCardIds {
+		_, _, err := c.redeemGiftCard(ctx, tx, "id", giftCardId, customerId, nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get the active gift cards which have passed their expiry.
+func (c postgresController) GetExpiredGiftCards(ctx context.Context) ([]*pb.GiftCard, error) {
+	rows, err := c.cl.Query(
+		ctx,
+		pgGiftCardBaseQuery+" WHERE status=$1 AND expires_at <= timezone('utc', now())",
+		pb.GiftCardStatus_GIFT_CARD_STATUS_ACTIVE,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching gift cards", err)
+	}
+	defer rows.Close()
+
+	giftCards := []*pb.GiftCard{}
+	for rows.Next() {
+		giftCard, err := scanRowToGiftCard(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		giftCards = append(giftCards, giftCard)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning gift card rows", rows.Err())
+	}
+
+	return giftCards, nil
+}
+
+// Expire a gift card, forfeiting any remaining value.
+func (c postgresController) ExpireGiftCard(ctx context.Context, giftCardId string) (*pb.GiftCard, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	giftCard, err := c.getGiftCard(ctx, tx, "id", giftCardId)
+	if err != nil {
+		return nil, err
+	}
+
+	if giftCard.Status != pb.GiftCardStatus_GIFT_CARD_STATUS_ACTIVE {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "gift card is not active")
+	} else if giftCard.ExpiresAt > time.Now().Unix() {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "gift card has not yet expired")
+	}
+
+	// Update the gift card
+	_, err = tx.Exec(ctx, "UPDATE gift_cards SET status=$1 WHERE id=$2", pb.GiftCardStatus_GIFT_CARD_STATUS_EXPIRED, giftCard.Id)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update gift card", err)
+	}
+	giftCard.Status = pb.GiftCardStatus_GIFT_CARD_STATUS_EXPIRED
+
+	// Record the forfeited value in the ledger
+	currency := giftCard.RemainingValue.GetCurrencyCode()
+	_, err = c.postLedgerEntry(ctx, tx, systemAccount("giftcards", currency), systemAccount("giftcard_breakage", currency), giftCard.RemainingValue, nil, "gift card expired")
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareGiftCardExpiredEvent(giftCard)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", giftCard.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return giftCard, nil
+}
+
+// Generate a random gift card code (e.g. "ABCD-EFGH-JKLM-NPQR").
+func generateGiftCardCode() (string, error) {
+	var code strings.Builder
+	max := big.NewInt(int64(len(giftCardCodeAlphabet)))
+	for i := 0; i < 16; i++ {
+		if i > 0 && i%4 == 0 {
+			code.WriteByte('-')
+		}
+
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.WrapServiceError(errors.ErrCodeService, "failed to generate gift card code", err)
+		}
+
+		code.WriteByte(giftCardCodeAlphabet[n.Int64()])
+	}
+
+	return code.String(), nil
+}
+
+// Gift card codes are matched case insensitively.
+func normaliseGiftCardCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// Scan a postgres row to a protobuf object
+func scanRowToGiftCard(row pgx.Row) (*pb.GiftCard, error) {
+	var giftCard pb.GiftCard
+
+	// Temporary variables that require conversion
+	var tmpInitialValue int64
+	var tmpRemainingValue int64
+	var tmpCurrency string
+	var tmpCustomerId pgtype.Text
+	var tmpIssuedAt pgtype.Timestamp
+	var tmpExpiresAt pgtype.Timestamp
+
+	err := row.Scan(
+		&giftCard.Id,
+		&giftCard.Code,
+		&tmpInitialValue,
+		&tmpRemainingValue,
+		&tmpCurrency,
+		&giftCard.Status,
+		&tmpCustomerId,
+		&tmpIssuedAt,
+		&tmpExpiresAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "gift card not found", err)
+		} else {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+	}
+
+	// Convert the temporary variables
+	giftCard.InitialValue = money.New(tmpInitialValue, tmpCurrency)
+	giftCard.RemainingValue = money.New(tmpRemainingValue, tmpCurrency)
+
+	if tmpCustomerId.Valid {
+		giftCard.CustomerId = &tmpCustomerId.String
+	}
+
+	// - converting postgres timestamps to unix format
+	if !tmpIssuedAt.Valid || !tmpExpiresAt.Valid {
+		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to scan object from database (timestamp conversion)")
+	}
+	giftCard.IssuedAt = tmpIssuedAt.Time.Unix()
+	giftCard.ExpiresAt = tmpExpiresAt.Time.Unix()
+
+	return &giftCard, nil
+}
@@ -51,11 +51,22 @@ func NewKafkaController(cl *kgo.Client) payment.ConsumerController {
 		messaging.Payment_Balance_Closed_Topic,
 		messaging.Payment_Transaction_Created_Topic,
 		messaging.Payment_Transaction_Reversed_Topic,
+		messaging.Payment_Transaction_Captured_Topic,
+		messaging.Payment_Transaction_Voided_Topic,
+		messaging.Payment_Unpaid_Shipment_Topic,
+		messaging.Payment_Gift_Card_Issued_Topic,
+		messaging.Payment_Gift_Card_Redeemed_Topic,
+		messaging.Payment_Gift_Card_Expired_Topic,
 		messaging.Payment_Processing_Topic,
+		messaging.Payment_Refund_Topic,
 
 		messaging.User_State_Created_Topic,
 
 		messaging.Shipping_Shipment_Allocation_Topic,
+		messaging.Shipping_Shipment_Dispatched_Topic,
+
+		messaging.Order_State_Rejected_Topic,
+		messaging.Order_Return_Approved_Topic,
 	)
 	if err != nil {
 		log.Warn().Err(err).Msg("kafka: raised attempting to ensure svc topics")
@@ -65,6 +76,9 @@ func NewKafkaController(cl *kgo.Client) payment.ConsumerController {
 	cl.AddConsumeTopics(
 		messaging.User_State_Created_Topic,
 		messaging.Shipping_Shipment_Allocation_Topic,
+		messaging.Shipping_Shipment_Dispatched_Topic,
+		messaging.Order_State_Rejected_Topic,
+		messaging.Order_Return_Approved_Topic,
 	)
 
 	return &kafkaController{cl: cl, ctx: ctx, ctxCancel: ctxCancel}
@@ -91,6 +105,12 @@ func (c *kafkaController) Start() {
 				c.consumeUserCreatedEventTopic(ft)
 			case messaging.Shipping_Shipment_Allocation_Topic:
 				c.consumeShipmentAllocationEventTopic(ft)
+			case messaging.Shipping_Shipment_Dispatched_Topic:
+				c.consumeShipmentDispatchedEventTopic(ft)
+			case messaging.Order_State_Rejected_Topic:
+				c.consumeOrderRejectedEventTopic(ft)
+			case messaging.Order_Return_Approved_Topic:
+				c.consumeReturnApprovedEventTopic(ft)
 			default:
 				log.Warn().Str("topic", ft.Topic).Msg("consumer: received records from unexpected topic")
 			}
@@ -138,3 +158,57 @@ func (c *kafkaController) consumeShipmentAllocationEventTopic(ft kgo.FetchTopic)
 		c.svc.ProcessShipmentAllocationEvent(ctx, &event)
 	})
 }
+
+func (c *kafkaController) consumeShipmentDispatchedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.ShipmentDispatchedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessShipmentDispatchedEvent(ctx, &event)
+	})
+}
+
+func (c *kafkaController) consumeOrderRejectedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.OrderRejectedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessOrderRejectedEvent(ctx, &event)
+	})
+}
+
+func (c *kafkaController) consumeReturnApprovedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.ReturnApprovedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessReturnApprovedEvent(ctx, &event)
+	})
+}
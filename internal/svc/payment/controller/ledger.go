@@ -0,0 +1,302 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
+	"github.com/hexolan/stocklet/internal/svc/payment"
+)
+
+// Every movement of funds into, out of or between customer balances is recorded
+// in the ledger as an entry debiting one account and crediting another.
+//
+// The balance of an account is the sum of the entries crediting it, less
+// the sum of the entries debiting it. The amounts stored in customer_balances
+// are a cache of the customer accounts, which can be reconciled against the ledger.
+
+// The account holding a customer's available balance.
+func customerBalanceAccount(customerId string) string {
+	return "customer:" + customerId + ":balance"
+}
+
+// The account holding a customer's funds held for authorized payments.
+func customerHeldAccount(customerId string) string {
+	return "customer:" + customerId + ":held"
+}
+
+// Accounts representing funds external to customer balances (per currency).
+//
+// - "funding": funds topped up from external sources
+// - "adjustments": funds credited by administrative adjustments
+// - "revenue": funds taken for orders (and returned by refunds)
+// - "closed": funds remaining in balances when they were closed
+func systemAccount(name string, currency string) string {
+	return "system:" + name + ":" + currency
+}
+
+// The account that a balance credit is funded from.
+func creditSourceAccount(customerId string, credit *payment.BalanceCredit, currency string) string {
+	switch credit.Source {
+	case eventpb.BalanceCreditedEvent_SOURCE_TOP_UP:
+		return systemAccount("funding", currency)
+	case eventpb.BalanceCreditedEvent_SOURCE_ADJUSTMENT:
+		return systemAccount("adjustments", currency)
+	case eventpb.BalanceCreditedEvent_SOURCE_REFUND:
+		return systemAccount("revenue", currency)
+	case eventpb.BalanceCreditedEvent_SOURCE_RELEASED_HOLD:
+		return customerHeldAccount(customerId)
+	case eventpb.BalanceCreditedEvent_SOURCE_GIFT_CARD:
+		return systemAccount("giftcards", currency)
+	default:
+		return systemAccount("suspense", currency)
+	}
+}
+
+// Get the id of a ledger account (opening the account if it does not exist).
+//
+// Existing accounts are looked up without locking them, as the shared
+// system accounts are posted to by most transactions.
+func (c postgresController) getLedgerAccountId(ctx context.Context, tx pgx.Tx, code string, currency string) (int64, error) {
+	var accountId int64
+	err := tx.QueryRow(ctx, "SELECT id FROM ledger_accounts WHERE code=$1", code).Scan(&accountId)
+	if err == nil {
+		return accountId, nil
+	} else if err != pgx.ErrNoRows {
+		return 0, errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch ledger account", err)
+	}
+
+	// Open the account
+	err = tx.QueryRow(
+		ctx,
+		"INSERT INTO ledger_accounts (code, currency) VALUES ($1, $2) ON CONFLICT (code) DO NOTHING RETURNING id",
+		code,
+		currency,
+	).Scan(&accountId)
+	if err == nil {
+		return accountId, nil
+	} else if err != pgx.ErrNoRows {
+		return 0, errors.WrapServiceError(errors.ErrCodeExtService, "failed to open ledger account", err)
+	}
+
+	// The account was opened concurrently
+	err = tx.QueryRow(ctx, "SELECT id FROM ledger_accounts WHERE code=$1", code).Scan(&accountId)
+	if err != nil {
+		return 0, errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch ledger account", err)
+	}
+
+	return accountId, nil
+}
+
+// The customer and column of customer_balances that cache a customer account.
+func customerAccountColumn(code string) (string, string, bool) {
+	account, ok := strings.CutPrefix(code, "customer:")
+	if !ok {
+		return "", "", false
+	}
+
+	if customerId, ok := strings.CutSuffix(account, ":balance"); ok {
+		return customerId, "balance", true
+	} else if customerId, ok := strings.CutSuffix(account, ":held"); ok {
+		return customerId, "held", true
+	}
+
+	return "", "", false
+}
+
+// Record a movement of funds between two ledger accounts.
+//
+// Movements into or out of a customer's accounts are applied to the cached
+// amounts in customer_balances alongside the entry, so that balances only
+// ever change with the ledger.
+//
+// Returns false (without recording the entry) if the customer does not hold
+// a balance in the currency, or if their debited account does not cover the amount.
+func (c postgresController) postLedgerEntry(ctx context.Context, tx pgx.Tx, debitAccount string, creditAccount string, amount *commonpb.Money, transactionId *string, description string) (bool, error) {
+	// Entries are only recorded for actual movements of funds
+	if amount.GetMinorUnits() == 0 {
+		return true, nil
+	} else if amount.GetMinorUnits() < 0 {
+		return false, errors.NewServiceError(errors.ErrCodeService, "ledger entries must be positive")
+	}
+
+	// Apply the movement to the cached customer balance
+	var (
+		customerId string
+		changes    []string
+		conditions string
+	)
+	if debitCustomerId, column, ok := customerAccountColumn(debitAccount); ok {
+		customerId = debitCustomerId
+		changes = append(changes, column+" = "+column+" - $1")
+		conditions = " AND " + column + " >= $1"
+	}
+
+	if creditCustomerId, column, ok := customerAccountColumn(creditAccount); ok {
+		if customerId != "" && customerId != creditCustomerId {
+			return false, errors.NewServiceError(errors.ErrCodeService, "ledger entries cannot move funds between customers")
+		}
+
+		customerId = creditCustomerId
+		changes = append(changes, column+" = "+column+" + $1")
+	}
+
+	if customerId != "" {
+		result, err := tx.Exec(
+			ctx,
+			"UPDATE customer_balances SET "+strings.Join(changes, ", ")+" WHERE customer_id=$2 AND currency=$3"+conditions,
+			amount.GetMinorUnits(),
+			customerId,
+			amount.GetCurrencyCode(),
+		)
+		if err != nil {
+			return false, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update balance", err)
+		} else if result.RowsAffected() == 0 {
+			return false, nil
+		}
+	}
+
+	// Record the entry
+	debitAccountId, err := c.getLedgerAccountId(ctx, tx, debitAccount, amount.GetCurrencyCode())
+	if err != nil {
+		return false, err
+	}
+
+	creditAccountId, err := c.getLedgerAccountId(ctx, tx, creditAccount, amount.GetCurrencyCode())
+	if err != nil {
+		return false, err
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		"INSERT INTO ledger_entries (transaction_id, debit_account_id, credit_account_id, amount, currency, description) VALUES ($1, $2, $3, $4, $5, $6)",
+		transactionId,
+		debitAccountId,
+		creditAccountId,
+		amount.GetMinorUnits(),
+		amount.GetCurrencyCode(),
+		description,
+	)
+	if err != nil {
+		return false, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert ledger entry", err)
+	}
+
+	return true, nil
+}
+
+// Compare the cached customer balances against the ledger.
+//
+// If repair is true, then the cached balances which have drifted
+// are reset to the amounts derived from the ledger.
+func (c postgresController) ReconcileBalances(ctx context.Context, repair bool) ([]*payment.BalanceDrift, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the drifted balances whilst reconciling
+	// (only these are selected, so that other balances remain available for payments)
+	rows, err := tx.Query(
+		ctx,
+		`SELECT b.customer_id, b.currency, b.balance, b.held, COALESCE(lb.balance, 0), COALESCE(lh.balance, 0)
+		FROM customer_balances b
+		LEFT JOIN ledger_account_balances lb ON lb.code = 'customer:' || b.customer_id || ':balance'
+		LEFT JOIN ledger_account_balances lh ON lh.code = 'customer:' || b.customer_id || ':held'
+		WHERE b.balance <> COALESCE(lb.balance, 0) OR b.held <> COALESCE(lh.balance, 0)
+		FOR UPDATE OF b`,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "query error whilst reconciling balances", err)
+	}
+	defer rows.Close()
+
+	drifts := []*payment.BalanceDrift{}
+	for rows.Next() {
+		var (
+			customerId    string
+			currency      string
+			cachedBalance int64
+			cachedHeld    int64
+			ledgerBalance int64
+			ledgerHeld    int64
+		)
+
+		err := rows.Scan(&customerId, &currency, &cachedBalance, &cachedHeld, &ledgerBalance, &ledgerHeld)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+
+		if drift := newBalanceDrift(customerId, currency, cachedBalance, cachedHeld, ledgerBalance, ledgerHeld); drift != nil {
+			drifts = append(drifts, drift)
+		}
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning balance rows", rows.Err())
+	}
+
+	if !repair {
+		return drifts, nil
+	}
+
+	// Reset the drifted balances to the ledger amounts
+	for _, drift := range drifts {
+		_, err = tx.Exec(
+			ctx,
+			"UPDATE customer_balances SET balance = $1, held = $2 WHERE customer_id=$3",
+			drift.LedgerBalance.GetMinorUnits(),
+			drift.LedgerHeld.GetMinorUnits(),
+			drift.CustomerId,
+		)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update balance", err)
+		}
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return drifts, nil
+}
+
+// Compare the cached amounts of a customer's balance against the ledger.
+//
+// Returns nil if the cached amounts match the ledger.
+func newBalanceDrift(customerId string, currency string, cachedBalance int64, cachedHeld int64, ledgerBalance int64, ledgerHeld int64) *payment.BalanceDrift {
+	if cachedBalance == ledgerBalance && cachedHeld == ledgerHeld {
+		return nil
+	}
+
+	return &payment.BalanceDrift{
+		CustomerId:    customerId,
+		CachedBalance: money.New(cachedBalance, currency),
+		LedgerBalance: money.New(ledgerBalance, currency),
+		CachedHeld:    money.New(cachedHeld, currency),
+		LedgerHeld:    money.New(ledgerHeld, currency),
+	}
+}
@@ -17,28 +17,40 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/doug-martin/goqu/v9"
+	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/fx"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
 	"github.com/hexolan/stocklet/internal/svc/payment"
 )
 
 const (
-	pgTransactionBaseQuery     string = "SELECT id, order_id, customer_id, amount, reversed_at, processed_at FROM transactions"
-	pgCustomerBalanceBaseQuery string = "SELECT customer_id, balance FROM customer_balances"
+	pgTransactionBaseQuery     string = "SELECT id, order_id, customer_id, amount, currency, charge_amount, charge_currency, exchange_rate, refunded_amount, payment_method, provider_reference, status, expires_at, captured_at, type, balance_after, balance_currency, reversed_at, processed_at FROM transactions"
+	pgCustomerBalanceBaseQuery string = "SELECT customer_id, balance, held, currency, opened_at FROM customer_balances"
+	pgStatementBaseQuery       string = "SELECT id, customer_id, period_start, period_end, opening_balance, closing_balance, currency, transaction_count, created_at FROM statements"
 )
 
 type postgresController struct {
-	cl *pgxpool.Pool
+	cl          *pgxpool.Pool
+	serviceOpts *payment.ServiceConfigOpts
+	rates       fx.RateProvider
 }
 
-func NewPostgresController(cl *pgxpool.Pool) payment.StorageController {
-	return postgresController{cl: cl}
+func NewPostgresController(cl *pgxpool.Pool, serviceOpts *payment.ServiceConfigOpts, rates fx.RateProvider) payment.StorageController {
+	return postgresController{cl: cl, serviceOpts: serviceOpts, rates: rates}
 }
 
 func (c postgresController) GetBalance(ctx context.Context, customerId string) (*pb.CustomerBalance, error) {
@@ -64,6 +76,182 @@ func (c postgresController) getBalance(ctx context.Context, tx *pgx.Tx, customer
 	return balance, nil
 }
 
+// Get the authorized (uncaptured) transactions for an order.
+func (c postgresController) GetOrderAuthorizations(ctx context.Context, orderId string) ([]*pb.Transaction, error) {
+	return c.getTransactions(ctx, nil, pgTransactionBaseQuery+" WHERE order_id=$1 AND status=$2", orderId, pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED)
+}
+
+// Check if payment for an order has been captured (or is being captured).
+func (c postgresController) HasCapturedOrderPayment(ctx context.Context, orderId string) (bool, error) {
+	var captured bool
+	err := c.cl.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM transactions WHERE order_id=$1 AND type=$2 AND (status=$3 OR status=$4))",
+		orderId,
+		pb.TransactionType_TRANSACTION_TYPE_DEBIT,
+		pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+		pb.TransactionStatus_TRANSACTION_STATUS_CAPTURING,
+	).Scan(&captured)
+	if err != nil {
+		return false, errors.WrapServiceError(errors.ErrCodeExtService, "failed to check for captured payment", err)
+	}
+
+	return captured, nil
+}
+
+// Get the authorized transactions which have passed their expiry.
+func (c postgresController) GetExpiredAuthorizations(ctx context.Context) ([]*pb.Transaction, error) {
+	return c.getTransactions(ctx, nil, pgTransactionBaseQuery+" WHERE status=$1 AND expires_at <= timezone('utc', now())", pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED)
+}
+
+// Count the payments (debits) made by a customer within a recent window.
+func (c postgresController) CountRecentPayments(ctx context.Context, customerId string, window time.Duration) (int64, error) {
+	return c.countRecentPayments(ctx, nil, customerId, window)
+}
+
+func (c postgresController) countRecentPayments(ctx context.Context, tx *pgx.Tx, customerId string, window time.Duration) (int64, error) {
+	// Determine if a db transaction is being used
+	var row pgx.Row
+	const query = "SELECT COUNT(*) FROM transactions WHERE customer_id=$1 AND type=$2 AND processed_at >= timezone('utc', now()) - $3::double precision * interval '1 second'"
+	if tx == nil {
+		row = c.cl.QueryRow(ctx, query, customerId, pb.TransactionType_TRANSACTION_TYPE_DEBIT, window.Seconds())
+	} else {
+		row = (*tx).QueryRow(ctx, query, customerId, pb.TransactionType_TRANSACTION_TYPE_DEBIT, window.Seconds())
+	}
+
+	var count int64
+	err := row.Scan(&count)
+	if err != nil {
+		return 0, errors.WrapServiceError(errors.ErrCodeExtService, "failed to count recent payments", err)
+	}
+
+	return count, nil
+}
+
+// Check a prospective payment against the velocity rules within a transaction.
+//
+// The customer's payments are locked until the transaction has completed, so that
+// concurrent payments are counted against the rules in turn (rather than each passing).
+//
+// Returns the reason if the payment would exceed any of the rules.
+func (c postgresController) checkVelocityRules(ctx context.Context, tx pgx.Tx, customerId string, velocityRules []*payment.RiskRule) (commonpb.PaymentRiskReason, error) {
+	if len(velocityRules) == 0 {
+		return commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED, nil
+	}
+
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('payments:' || $1))", customerId)
+	if err != nil {
+		return commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED, errors.WrapServiceError(errors.ErrCodeExtService, "failed to lock customer payments", err)
+	}
+
+	for _, rule := range velocityRules {
+		count, err := c.countRecentPayments(ctx, &tx, customerId, rule.Window)
+		if err != nil {
+			return commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED, err
+		}
+
+		if count >= rule.MaxPayments {
+			return commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_VELOCITY_LIMIT, nil
+		}
+	}
+
+	return commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED, nil
+}
+
+// List a customer's transactions (most recent first).
+//
+// Page tokens encode the id of the last transaction on the previous page.
+func (c postgresController) ListTransactions(ctx context.Context, customerId string, filter *payment.TransactionFilter, pageSize int32, pageToken string) ([]*pb.Transaction, string, error) {
+	query := goqu.Dialect("postgres").From("transactions").Select(
+		"id", "order_id", "customer_id", "amount", "currency", "charge_amount", "charge_currency", "exchange_rate", "refunded_amount",
+		"payment_method", "provider_reference", "status", "expires_at", "captured_at", "type", "balance_after", "balance_currency", "reversed_at", "processed_at",
+	).Where(goqu.C("customer_id").Eq(customerId))
+
+	// Apply the filters
+	if filter.StartTime != nil {
+		query = query.Where(goqu.L("processed_at >= to_timestamp(?) AT TIME ZONE 'utc'", *filter.StartTime))
+	}
+
+	if filter.EndTime != nil {
+		query = query.Where(goqu.L("processed_at < to_timestamp(?) AT TIME ZONE 'utc'", *filter.EndTime))
+	}
+
+	if filter.OrderId != nil {
+		query = query.Where(goqu.C("order_id").Eq(*filter.OrderId))
+	}
+
+	if filter.Type != pb.TransactionType_TRANSACTION_TYPE_UNSPECIFIED {
+		query = query.Where(goqu.C("type").Eq(filter.Type))
+	}
+
+	// Continue from the previous page
+	if pageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, "", errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+		}
+
+		lastId, err := strconv.ParseInt(string(decoded), 10, 64)
+		if err != nil {
+			return nil, "", errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+		}
+
+		query = query.Where(goqu.C("id").Lt(lastId))
+	}
+
+	// An additional transaction is fetched to determine if there is a further page
+	statement, args, err := query.Order(goqu.C("id").Desc()).Limit(uint(pageSize) + 1).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, "", errors.WrapServiceError(errors.ErrCodeService, "failed to build statement", err)
+	}
+
+	transactions, err := c.getTransactions(ctx, nil, statement, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextPageToken := ""
+	if len(transactions) > int(pageSize) {
+		transactions = transactions[:pageSize]
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(transactions[pageSize-1].Id))
+	}
+
+	return transactions, nextPageToken, nil
+}
+
+func (c postgresController) getTransactions(ctx context.Context, tx *pgx.Tx, query string, args ...any) ([]*pb.Transaction, error) {
+	// Determine if a db transaction is being used
+	var (
+		rows pgx.Rows
+		err  error
+	)
+	if tx == nil {
+		rows, err = c.cl.Query(ctx, query, args...)
+	} else {
+		rows, err = (*tx).Query(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching transactions", err)
+	}
+	defer rows.Close()
+
+	transactions := []*pb.Transaction{}
+	for rows.Next() {
+		transaction, err := scanRowToTransaction(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, transaction)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning transaction rows", rows.Err())
+	}
+
+	return transactions, nil
+}
+
 func (c postgresController) GetTransaction(ctx context.Context, transactionId string) (*pb.Transaction, error) {
 	return c.getTransaction(ctx, nil, transactionId)
 }
@@ -87,7 +275,8 @@ func (c postgresController) getTransaction(ctx context.Context, tx *pgx.Tx, tran
 	return transaction, nil
 }
 
-func (c postgresController) CreateBalance(ctx context.Context, customerId string) error {
+// Create a balance for a customer (held in their preferred currency).
+func (c postgresController) CreateBalance(ctx context.Context, customerId string, currency string) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -97,16 +286,17 @@ func (c postgresController) CreateBalance(ctx context.Context, customerId string
 
 	_, err = tx.Exec(
 		ctx,
-		"INSERT INTO customer_balances (customer_id, balance) VALUES ($1, $2)",
+		"INSERT INTO customer_balances (customer_id, balance, currency) VALUES ($1, $2, $3)",
 		customerId,
-		0.00,
+		0,
+		currency,
 	)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to create balance", err)
 	}
 
 	// Add the event to the outbox table with the transaction
-	evt, evtTopic, err := payment.PrepareBalanceCreatedEvent(&pb.CustomerBalance{CustomerId: customerId, Balance: 0.00})
+	evt, evtTopic, err := payment.PrepareBalanceCreatedEvent(&pb.CustomerBalance{CustomerId: customerId, Balance: money.New(0, currency), Held: money.New(0, currency)})
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
@@ -125,29 +315,95 @@ func (c postgresController) CreateBalance(ctx context.Context, customerId string
 	return nil
 }
 
-func (c postgresController) CreditBalance(ctx context.Context, customerId string, amount float32) error {
-	// Begin a DB transaction
-	tx, err := c.cl.Begin(ctx)
+// Credit a top up (charged to the funding source) to a customer's balance.
+func (c postgresController) TopUpBalance(ctx context.Context, customerId string, amount *commonpb.Money, reference string) (*pb.CustomerBalance, error) {
+	return c.creditBalance(ctx, nil, customerId, amount, &payment.BalanceCredit{
+		Source:    eventpb.BalanceCreditedEvent_SOURCE_TOP_UP,
+		Reference: &reference,
+	})
+}
+
+// Credit an administrative adjustment to a customer's balance.
+func (c postgresController) AdjustBalance(ctx context.Context, customerId string, amount *commonpb.Money, reason pb.BalanceAdjustmentReason, note *string) (*pb.CustomerBalance, error) {
+	return c.creditBalance(ctx, nil, customerId, amount, &payment.BalanceCredit{
+		Source:           eventpb.BalanceCreditedEvent_SOURCE_ADJUSTMENT,
+		AdjustmentReason: reason,
+		AdjustmentNote:   note,
+	})
+}
+
+// Credit an amount to a customer's balance.
+//
+// The amount is converted to the currency of the balance (if required).
+func (c postgresController) creditBalance(ctx context.Context, tx *pgx.Tx, customerId string, amount *commonpb.Money, credit *payment.BalanceCredit) (*pb.CustomerBalance, error) {
+	// Determine if a transaction has already been provided
+	var (
+		funcTx pgx.Tx
+		err    error
+	)
+	if tx != nil {
+		funcTx = *tx
+	} else {
+		funcTx, err = c.cl.Begin(ctx)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+		}
+		defer funcTx.Rollback(ctx)
+	}
+
+	// Convert the amount to the currency of the balance
+	balance, err := c.getBalance(ctx, &funcTx, customerId)
 	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+		return nil, err
 	}
-	defer tx.Rollback(ctx)
 
-	// Add to balance
-	_, err = tx.Exec(
+	charge := amount
+	amount, exchangeRate, err := fx.Convert(ctx, c.rates, charge, balance.Balance.GetCurrencyCode())
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeInvalidState, "failed to convert currency", err)
+	}
+
+	// Record the credit in the customer's transaction history
+	txType := pb.TransactionType_TRANSACTION_TYPE_CREDIT
+	if credit.Source == eventpb.BalanceCreditedEvent_SOURCE_REFUND || credit.Source == eventpb.BalanceCreditedEvent_SOURCE_RELEASED_HOLD {
+		txType = pb.TransactionType_TRANSACTION_TYPE_REVERSAL
+	}
+
+	var providerReference *string
+	if credit.Source == eventpb.BalanceCreditedEvent_SOURCE_TOP_UP || credit.Source == eventpb.BalanceCreditedEvent_SOURCE_GIFT_CARD {
+		providerReference = credit.Reference
+	}
+
+	transaction, err := c.createTransaction(ctx, &funcTx, txType, credit.OrderId, customerId, amount, charge, exchangeRate, commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE, providerReference, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add to balance (recording the credit in the ledger)
+	credited, err := c.postLedgerEntry(
 		ctx,
-		"UPDATE customer_balances SET balance = balance + $1 WHERE customer_id=$2",
+		funcTx,
+		creditSourceAccount(customerId, credit, amount.GetCurrencyCode()),
+		customerBalanceAccount(customerId),
 		amount,
-		customerId,
+		&transaction.Id,
+		"balance credited ("+credit.Source.String()+")",
 	)
 	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update balance", err)
+		return nil, err
+	} else if !credited {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "balance not found")
+	}
+
+	err = c.recordRunningBalance(ctx, funcTx, transaction.Id)
+	if err != nil {
+		return nil, err
 	}
 
 	// Get updated balance
-	balance, err := c.getBalance(ctx, &tx, customerId)
+	balance, err = c.getBalance(ctx, &funcTx, customerId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Add the event to the outbox table with the transaction
@@ -155,30 +411,40 @@ func (c postgresController) CreditBalance(ctx context.Context, customerId string
 		balance.CustomerId,
 		amount,
 		balance.Balance,
+		credit,
 	)
 	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
 
-	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", balance.CustomerId, evtTopic, evt)
+	_, err = funcTx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", balance.CustomerId, evtTopic, evt)
 	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
 
-	// Commit the transaction
-	err = tx.Commit(ctx)
-	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	// Commit the transaction (if created in this func)
+	if tx == nil {
+		err = funcTx.Commit(ctx)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+		}
 	}
 
-	return nil
+	return balance, nil
 }
 
-func (c postgresController) DebitBalance(ctx context.Context, customerId string, amount float32, orderId *string) (*pb.Transaction, error) {
-	return c.debitBalance(ctx, nil, customerId, amount, orderId)
+func (c postgresController) DebitBalance(ctx context.Context, customerId string, amount *commonpb.Money, orderId *string) (*pb.Transaction, error) {
+	return c.debitBalance(ctx, nil, customerId, amount, orderId, false)
 }
 
-func (c postgresController) debitBalance(ctx context.Context, tx *pgx.Tx, customerId string, amount float32, orderId *string) (*pb.Transaction, error) {
+// Debit a charge from a customer's balance.
+//
+// The charge is converted to the currency of the balance (if required),
+// with the charge and exchange rate used recorded on the transaction.
+//
+// If hold is true, then the funds are held for an authorized transaction
+// (to be captured or released later) rather than taken immediately.
+func (c postgresController) debitBalance(ctx context.Context, tx *pgx.Tx, customerId string, charge *commonpb.Money, orderId *string, hold bool) (*pb.Transaction, error) {
 	// Determine if a transaction has already been provided
 	var (
 		funcTx pgx.Tx
@@ -194,28 +460,55 @@ func (c postgresController) debitBalance(ctx context.Context, tx *pgx.Tx, custom
 		defer funcTx.Rollback(ctx)
 	}
 
-	// Subtract from balance
-	_, err = funcTx.Exec(
-		ctx,
-		"UPDATE customer_balances SET balance = balance - $1 WHERE customer_id=$2",
-		amount,
-		customerId,
-	)
+	// Convert the charge to the currency of the balance
+	balance, err := c.getBalance(ctx, &funcTx, customerId)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update balance", err)
+		return nil, err
 	}
 
-	// Get updated balance
-	balance, err := c.getBalance(ctx, &funcTx, customerId)
+	amount, exchangeRate, err := fx.Convert(ctx, c.rates, charge, balance.Balance.GetCurrencyCode())
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeInvalidState, "failed to update balance: unable to convert currency", err)
+	}
+
+	// Create a payment transaction record
+	transaction, err := c.createTransaction(ctx, &funcTx, pb.TransactionType_TRANSACTION_TYPE_DEBIT, orderId, customerId, amount, charge, exchangeRate, commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE, nil, hold)
 	if err != nil {
 		return nil, err
 	}
 
-	// Ensure that the balance is not negative
-	if balance.Balance < 0.00 {
+	// Subtract from balance (recording the debit in the ledger)
+	//
+	// The balance is only debited if it covers the amount,
+	// so that declined debits do not leave the balance negative.
+	var debited bool
+	if hold {
+		debited, err = c.postLedgerEntry(ctx, funcTx, customerBalanceAccount(customerId), customerHeldAccount(customerId), amount, &transaction.Id, "funds held for payment")
+	} else {
+		debited, err = c.postLedgerEntry(ctx, funcTx, customerBalanceAccount(customerId), systemAccount("revenue", amount.GetCurrencyCode()), amount, &transaction.Id, "payment taken")
+	}
+	if err != nil {
+		return nil, err
+	} else if !debited {
 		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "insufficient balance")
 	}
 
+	err = c.recordRunningBalance(ctx, funcTx, transaction.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err = c.getTransaction(ctx, &funcTx, transaction.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get updated balance
+	balance, err = c.getBalance(ctx, &funcTx, customerId)
+	if err != nil {
+		return nil, err
+	}
+
 	// Add the balance event to the outbox table with the transaction
 	evt, evtTopic, err := payment.PrepareBalanceDebitedEvent(
 		balance.CustomerId,
@@ -231,12 +524,6 @@ func (c postgresController) debitBalance(ctx context.Context, tx *pgx.Tx, custom
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
 
-	// Create a payment transaction record
-	transaction, err := c.createTransaction(ctx, &funcTx, orderId, customerId, amount)
-	if err != nil {
-		return nil, err
-	}
-
 	// Commit the transaction (if created in this func)
 	if tx == nil {
 		err = funcTx.Commit(ctx)
@@ -256,12 +543,35 @@ func (c postgresController) CloseBalance(ctx context.Context, customerId string)
 	}
 	defer tx.Rollback(ctx)
 
+	// Redeem the value remaining on the customer's gift cards
+	// - so that it is accounted for in the closed balance
+	err = c.redeemCustomerGiftCards(ctx, tx, customerId)
+	if err != nil {
+		return err
+	}
+
 	// Get current balance
 	balance, err := c.getBalance(ctx, &tx, customerId)
 	if err != nil {
 		return err
 	}
 
+	// Close the customer's accounts in the ledger
+	closedAccount := systemAccount("closed", balance.Balance.GetCurrencyCode())
+	closed, err := c.postLedgerEntry(ctx, tx, customerBalanceAccount(customerId), closedAccount, balance.Balance, nil, "balance closed")
+	if err != nil {
+		return err
+	} else if !closed {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "balance changed whilst closing")
+	}
+
+	closed, err = c.postLedgerEntry(ctx, tx, customerHeldAccount(customerId), closedAccount, balance.Held, nil, "balance closed (held funds)")
+	if err != nil {
+		return err
+	} else if !closed {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "balance changed whilst closing")
+	}
+
 	// Delete balance
 	_, err = tx.Exec(ctx, "DELETE FROM customer_balances WHERE customer_id=$1", customerId)
 	if err != nil {
@@ -288,7 +598,8 @@ func (c postgresController) CloseBalance(ctx context.Context, customerId string)
 	return nil
 }
 
-func (c postgresController) PaymentForOrder(ctx context.Context, orderId string, customerId string, amount float32) error {
+// Authorize payment for an order by holding the funds from the customer's balance.
+func (c postgresController) AuthorizeOrderPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, velocityRules []*payment.RiskRule) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -296,14 +607,23 @@ func (c postgresController) PaymentForOrder(ctx context.Context, orderId string,
 	}
 	defer tx.Rollback(ctx)
 
-	// Attempt to debit balance for the order
-	transaction, err := c.debitBalance(ctx, &tx, customerId, amount, &orderId)
+	// Ensure the payment is within the velocity limits
+	riskReason, err := c.checkVelocityRules(ctx, tx, customerId, velocityRules)
 	if err != nil {
-		// check that error is not a result of insufficient balance
-		// - or the customer not having a balance
-		errText := err.Error()
-		if errText != "insufficient balance" && !strings.HasPrefix(errText, "failed to update balance") {
-			return err
+		return err
+	}
+
+	var transaction *pb.Transaction
+	if riskReason == commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+		// Attempt to hold funds from the balance for the order
+		transaction, err = c.holdOrderPayment(ctx, tx, orderId, customerId, amount)
+		if err != nil {
+			// check that the error is a result of the payment being declined
+			// (e.g. insufficient balance or the customer not having a balance)
+			svcErr, ok := err.(*errors.ServiceError)
+			if !ok || svcErr.Code() == errors.ErrCodeExtService || svcErr.Code() == errors.ErrCodeService {
+				return err
+			}
 		}
 	}
 
@@ -317,8 +637,8 @@ func (c postgresController) PaymentForOrder(ctx context.Context, orderId string,
 		evt, evtTopic, err = payment.PreparePaymentProcessedEvent_Success(transaction)
 	} else {
 		// Failure
-		// - result of insufficient/non-existent balance
-		evt, evtTopic, err = payment.PreparePaymentProcessedEvent_Failure(orderId, customerId, amount)
+		// - result of insufficient/non-existent balance (or exceeding the velocity limits)
+		evt, evtTopic, err = payment.PreparePaymentProcessedEvent_Failure(orderId, customerId, amount, commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE, riskReason)
 	}
 
 	// Ensure the event was prepared successfully
@@ -341,56 +661,596 @@ func (c postgresController) PaymentForOrder(ctx context.Context, orderId string,
 	return nil
 }
 
-func (c postgresController) createTransaction(ctx context.Context, tx *pgx.Tx, orderId *string, customerId string, amount float32) (*pb.Transaction, error) {
-	// Determine if a transaction has already been provided
-	var (
-		funcTx pgx.Tx
-		err    error
-	)
-	if tx != nil {
-		funcTx = *tx
-	} else {
-		funcTx, err = c.cl.Begin(ctx)
-		if err != nil {
-			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
-		}
-		defer funcTx.Rollback(ctx)
+// Hold funds from the customer's balance within a savepoint
+// - so that nothing is left behind if the hold is declined
+func (c postgresController) holdOrderPayment(ctx context.Context, tx pgx.Tx, orderId string, customerId string, amount *commonpb.Money) (*pb.Transaction, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
 	}
+	defer savepoint.Rollback(ctx)
 
-	// Insert the transaction
-	var transactionId string
-	err = funcTx.QueryRow(
-		ctx,
-		"INSERT INTO transactions (order_id, customer_id, amount) VALUES ($1, $2, $3) RETURNING id",
-		orderId,
-		customerId,
-		amount,
-	).Scan(&transactionId)
+	transaction, err := c.debitBalance(ctx, &savepoint, customerId, amount, &orderId, true)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert transaction", err)
+		return nil, err
 	}
 
-	// Get the transaction obj
-	transaction, err := c.getTransaction(ctx, &funcTx, transactionId)
+	// Release the savepoint
+	err = savepoint.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return transaction, nil
+}
+
+// Record a payment for an order authorized by an external provider.
+//
+// The customer's balance is unaffected.
+// If the payment would exceed the velocity limits, then a failure is recorded
+// instead and no transaction is returned.
+func (c postgresController) RecordOrderAuthorization(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, method commonpb.PaymentMethod, reference string, velocityRules []*payment.RiskRule) (*pb.Transaction, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ensure the payment is within the velocity limits
+	riskReason, err := c.checkVelocityRules(ctx, tx, customerId, velocityRules)
 	if err != nil {
 		return nil, err
+	} else if riskReason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+		// Release the lock before recording the failure
+		tx.Rollback(ctx)
+		return nil, c.RecordOrderPaymentFailure(ctx, orderId, customerId, amount, method, riskReason)
 	}
 
-	// Add the event to the outbox table with the transaction
-	evt, evtTopic, err := payment.PrepareTransactionLoggedEvent(transaction)
+	// Create a payment transaction record
+	transaction, err := c.createTransaction(ctx, &tx, pb.TransactionType_TRANSACTION_TYPE_DEBIT, &orderId, customerId, amount, amount, 1, method, &reference, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table
+	evt, evtTopic, err := payment.PreparePaymentProcessedEvent_Success(transaction)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
 
-	_, err = funcTx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", transaction.Id, evtTopic, evt)
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderId, evtTopic, evt)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
 
-	// Commit the transaction (if created in this func)
-	if tx == nil {
-		err = funcTx.Commit(ctx)
-		if err != nil {
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return transaction, nil
+}
+
+// Record that payment for an order could not be taken.
+func (c postgresController) RecordOrderPaymentFailure(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, method commonpb.PaymentMethod, riskReason commonpb.PaymentRiskReason) error {
+	evt, evtTopic, err := payment.PreparePaymentProcessedEvent_Failure(orderId, customerId, amount, method, riskReason)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = c.cl.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderId, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return nil
+}
+
+// Record that a shipment was dispatched without payment held for the order.
+func (c postgresController) RecordUnpaidShipment(ctx context.Context, orderId string, shipmentId string) error {
+	evt, evtTopic, err := payment.PrepareUnpaidShipmentEvent(orderId, shipmentId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = c.cl.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", shipmentId, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return nil
+}
+
+// Refund the items of an order return to the customer's balance.
+//
+// Refunds are only processed once for each return.
+func (c postgresController) RefundReturn(ctx context.Context, returnId string, orderId string, customerId string, amount *commonpb.Money) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ensure the return has not already been refunded
+	var refunded bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM refunds WHERE return_id=$1)", returnId).Scan(&refunded)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to check for existing refund", err)
+	} else if refunded {
+		return nil
+	}
+
+	// Check that the customer has a balance to refund to
+	var hasBalance bool
+	err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM customer_balances WHERE customer_id=$1)", customerId).Scan(&hasBalance)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to check for balance", err)
+	}
+
+	// Allocate the refund between the captured payments for the order (locking them)
+	// - so that the refunded amounts cannot exceed what was paid
+	payments, err := c.getTransactions(
+		ctx,
+		&tx,
+		pgTransactionBaseQuery+" WHERE order_id=$1 AND type=$2 AND status=$3 ORDER BY id FOR UPDATE",
+		orderId,
+		pb.TransactionType_TRANSACTION_TYPE_DEBIT,
+		pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+	)
+	if err != nil {
+		return err
+	}
+
+	reversals, refundable := allocateRefund(payments, amount)
+
+	// Prepare response event
+	var (
+		evt      []byte
+		evtTopic string
+	)
+	if hasBalance && refundable {
+		// Record the refund
+		var refundId string
+		err = tx.QueryRow(
+			ctx,
+			"INSERT INTO refunds (return_id, order_id, customer_id, amount, currency) VALUES ($1, $2, $3, $4, $5) RETURNING id",
+			returnId,
+			orderId,
+			customerId,
+			amount.GetMinorUnits(),
+			amount.GetCurrencyCode(),
+		).Scan(&refundId)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to record refund", err)
+		}
+
+		// Reverse the refunded amounts from the order payments
+		for _, reversal := range reversals {
+			_, err = c.reverseTransaction(ctx, tx, reversal.transactionId, reversal.amount)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Credit the refund to the customer's balance
+		_, err = c.creditBalance(ctx, &tx, customerId, amount, &payment.BalanceCredit{
+			Source:    eventpb.BalanceCreditedEvent_SOURCE_REFUND,
+			Reference: &refundId,
+			OrderId:   &orderId,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Successful
+		evt, evtTopic, err = payment.PrepareRefundProcessedEvent_Success(refundId, returnId, orderId, customerId, amount)
+	} else {
+		// Failure
+		// - result of the customer not having a balance
+		// - or the order payments having already been refunded
+		evt, evtTopic, err = payment.PrepareRefundProcessedEvent_Failure(returnId, orderId, customerId, amount)
+	}
+
+	// Ensure the event was prepared successfully
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	// Add the event to the outbox table
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", returnId, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Refund a transaction to the customer's balance.
+//
+// If an amount is not specified, then the remaining (unrefunded) amount
+// of the transaction is refunded.
+func (c postgresController) RefundTransaction(ctx context.Context, transactionId string, amount *commonpb.Money) (*pb.Transaction, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Get the transaction
+	transaction, err := c.getTransaction(ctx, &tx, transactionId)
+	if err != nil {
+		return nil, err
+	} else if transaction.Status != pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "only captured transactions can be refunded")
+	}
+
+	// Ensure the refund amount is valid
+	remaining := transaction.Amount.GetMinorUnits() - transaction.RefundedAmount.GetMinorUnits()
+	if amount == nil {
+		amount = money.New(remaining, transaction.Amount.GetCurrencyCode())
+	} else if amount.GetCurrencyCode() != transaction.Amount.GetCurrencyCode() {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "refund must be in the currency of the transaction")
+	} else if amount.GetMinorUnits() <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "refund amount must be positive")
+	}
+
+	if remaining <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "transaction has already been refunded")
+	} else if amount.GetMinorUnits() > remaining {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "refund amount exceeds the remaining transaction amount")
+	}
+
+	// Mark the transaction as reversed
+	transaction, err = c.reverseTransaction(ctx, tx, transactionId, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// Credit the refund to the customer's balance
+	_, err = c.creditBalance(ctx, &tx, transaction.CustomerId, amount, &payment.BalanceCredit{
+		Source:    eventpb.BalanceCreditedEvent_SOURCE_REFUND,
+		Reference: &transaction.Id,
+		OrderId:   optionalString(transaction.OrderId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return transaction, nil
+}
+
+// Reverse an amount of a captured transaction (as part of a refund).
+//
+// The refunded amount is checked in the update to guard against concurrent
+// refunds exceeding the amount of the transaction.
+func (c postgresController) reverseTransaction(ctx context.Context, tx pgx.Tx, transactionId string, amount *commonpb.Money) (*pb.Transaction, error) {
+	result, err := tx.Exec(
+		ctx,
+		"UPDATE transactions SET refunded_amount = refunded_amount + $1, reversed_at = timezone('utc', now()) WHERE id=$2 AND status=$3 AND currency=$4 AND refunded_amount + $1 <= amount",
+		amount.GetMinorUnits(),
+		transactionId,
+		pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+		amount.GetCurrencyCode(),
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update transaction", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "refund amount exceeds the remaining transaction amount")
+	}
+
+	// Get the updated transaction
+	transaction, err := c.getTransaction(ctx, &tx, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareTransactionReversedEvent(transaction, amount)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", transaction.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return transaction, nil
+}
+
+// An amount to reverse from a transaction (in the currency of the transaction).
+type transactionReversal struct {
+	transactionId string
+	amount        *commonpb.Money
+}
+
+// Allocate a refund (in the charged currency) between the captured payments for an order.
+//
+// The payments are reversed in turn, until the refund is covered.
+// Returns false if the unrefunded amounts of the payments do not cover the refund.
+func allocateRefund(payments []*pb.Transaction, refund *commonpb.Money) ([]transactionReversal, bool) {
+	remaining := refund.GetMinorUnits()
+	reversals := []transactionReversal{}
+	for _, transaction := range payments {
+		if remaining <= 0 {
+			break
+		} else if transaction.ChargeAmount.GetCurrencyCode() != refund.GetCurrencyCode() {
+			continue
+		}
+
+		rate := transaction.ExchangeRate
+		if rate <= 0 {
+			rate = 1
+		}
+
+		// Determine the unrefunded amount of the payment (in the charged currency)
+		currency := transaction.Amount.GetCurrencyCode()
+		unrefunded := transaction.Amount.GetMinorUnits() - transaction.RefundedAmount.GetMinorUnits()
+		unrefundedCharge := money.Convert(unrefunded, currency, refund.GetCurrencyCode(), 1/rate)
+		if unrefunded <= 0 || unrefundedCharge <= 0 {
+			continue
+		}
+
+		share := min(remaining, unrefundedCharge)
+		reversals = append(reversals, transactionReversal{
+			transactionId: transaction.Id,
+			amount:        money.New(min(money.Convert(share, refund.GetCurrencyCode(), currency, rate), unrefunded), currency),
+		})
+		remaining -= share
+	}
+
+	return reversals, remaining <= 0
+}
+
+// Capture the held funds of an authorized transaction.
+func (c postgresController) CaptureTransaction(ctx context.Context, transactionId string) (*pb.Transaction, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Mark the transaction as captured
+	// - from authorized, or capturing (once captured by an external provider)
+	result, err := tx.Exec(
+		ctx,
+		"UPDATE transactions SET status = $1, captured_at = timezone('utc', now()) WHERE id=$2 AND (status=$3 OR status=$4)",
+		pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+		transactionId,
+		pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED,
+		pb.TransactionStatus_TRANSACTION_STATUS_CAPTURING,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update transaction", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "transaction is not awaiting capture")
+	}
+
+	transaction, err := c.getTransaction(ctx, &tx, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Take the held funds (for payments from the balance)
+	//
+	// The held funds were moved to the closed account if the balance has since been closed,
+	// in which case the entry is not recorded.
+	if transaction.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE {
+		_, err = c.postLedgerEntry(ctx, tx, customerHeldAccount(transaction.CustomerId), systemAccount("revenue", transaction.Amount.GetCurrencyCode()), transaction.Amount, &transaction.Id, "held funds captured")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareTransactionCapturedEvent(transaction)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", transaction.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return transaction, nil
+}
+
+// Move a transaction from one status to another.
+//
+// Used to claim an authorized transaction before it is captured
+// (or voided) by an external provider, so that the transaction
+// cannot be both captured and voided.
+func (c postgresController) TransitionTransaction(ctx context.Context, transactionId string, from pb.TransactionStatus, to pb.TransactionStatus) error {
+	result, err := c.cl.Exec(ctx, "UPDATE transactions SET status = $1 WHERE id=$2 AND status=$3", to, transactionId, from)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update transaction", err)
+	} else if result.RowsAffected() == 0 {
+		return errors.NewServiceErrorf(errors.ErrCodeInvalidState, "transaction is not %s", strings.ToLower(strings.TrimPrefix(from.String(), "TRANSACTION_STATUS_")))
+	}
+
+	return nil
+}
+
+// Release the held funds of an authorized transaction.
+//
+// Held balance funds are credited back to the customer's balance.
+func (c postgresController) VoidTransaction(ctx context.Context, transactionId string, reason eventpb.TransactionVoidedEvent_Reason) (*pb.Transaction, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Mark the transaction as voided (or expired)
+	status := pb.TransactionStatus_TRANSACTION_STATUS_VOIDED
+	if reason == eventpb.TransactionVoidedEvent_REASON_EXPIRED {
+		status = pb.TransactionStatus_TRANSACTION_STATUS_EXPIRED
+	}
+
+	// - from authorized, or voiding (once released by an external provider)
+	result, err := tx.Exec(
+		ctx,
+		"UPDATE transactions SET status = $1 WHERE id=$2 AND (status=$3 OR status=$4)",
+		status,
+		transactionId,
+		pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED,
+		pb.TransactionStatus_TRANSACTION_STATUS_VOIDING,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update transaction", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "transaction is not awaiting capture")
+	}
+
+	transaction, err := c.getTransaction(ctx, &tx, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the held funds (for payments from the balance)
+	if transaction.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE {
+		// The balance may have since been closed
+		var hasBalance bool
+		err = tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM customer_balances WHERE customer_id=$1)", transaction.CustomerId).Scan(&hasBalance)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to check for balance", err)
+		}
+
+		if hasBalance {
+			_, err = c.creditBalance(ctx, &tx, transaction.CustomerId, transaction.Amount, &payment.BalanceCredit{
+				Source:    eventpb.BalanceCreditedEvent_SOURCE_RELEASED_HOLD,
+				Reference: &transaction.Id,
+				OrderId:   optionalString(transaction.OrderId),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareTransactionVoidedEvent(transaction, reason)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", transaction.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return transaction, nil
+}
+
+// Create a transaction record.
+//
+// Authorized transactions are created with an expiry (after which the hold is voided),
+// whereas other transactions are captured immediately.
+func (c postgresController) createTransaction(ctx context.Context, tx *pgx.Tx, txType pb.TransactionType, orderId *string, customerId string, amount *commonpb.Money, charge *commonpb.Money, exchangeRate float64, method commonpb.PaymentMethod, reference *string, authorize bool) (*pb.Transaction, error) {
+	// Determine if a transaction has already been provided
+	var (
+		funcTx pgx.Tx
+		err    error
+	)
+	if tx != nil {
+		funcTx = *tx
+	} else {
+		funcTx, err = c.cl.Begin(ctx)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+		}
+		defer funcTx.Rollback(ctx)
+	}
+
+	// Determine the status of the transaction
+	status := pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED
+	var expirySeconds *float64
+	if authorize {
+		status = pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED
+		seconds := c.serviceOpts.AuthorizationExpiry.Seconds()
+		expirySeconds = &seconds
+	}
+
+	// Insert the transaction
+	var transactionId string
+	err = funcTx.QueryRow(
+		ctx,
+		"INSERT INTO transactions (type, order_id, customer_id, amount, currency, charge_amount, charge_currency, exchange_rate, payment_method, provider_reference, status, expires_at, captured_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, timezone('utc', now()) + $12::double precision * interval '1 second', CASE WHEN $12::double precision IS NULL THEN timezone('utc', now()) END) RETURNING id",
+		txType,
+		orderId,
+		customerId,
+		amount.GetMinorUnits(),
+		amount.GetCurrencyCode(),
+		charge.GetMinorUnits(),
+		charge.GetCurrencyCode(),
+		exchangeRate,
+		method,
+		reference,
+		status,
+		expirySeconds,
+	).Scan(&transactionId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert transaction", err)
+	}
+
+	// Record the running balance of the customer
+	err = c.recordRunningBalance(ctx, funcTx, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the transaction obj
+	transaction, err := c.getTransaction(ctx, &funcTx, transactionId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := payment.PrepareTransactionLoggedEvent(transaction)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = funcTx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", transaction.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction (if created in this func)
+	if tx == nil {
+		err = funcTx.Commit(ctx)
+		if err != nil {
 			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
 		}
 	}
@@ -398,19 +1258,254 @@ func (c postgresController) createTransaction(ctx context.Context, tx *pgx.Tx, o
 	return transaction, nil
 }
 
+// Record the running balance of a customer (if they hold a balance) on a transaction.
+func (c postgresController) recordRunningBalance(ctx context.Context, tx pgx.Tx, transactionId string) error {
+	_, err := tx.Exec(
+		ctx,
+		"UPDATE transactions SET balance_after = b.balance, balance_currency = b.currency FROM customer_balances b WHERE transactions.id=$1 AND b.customer_id = transactions.customer_id",
+		transactionId,
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update transaction", err)
+	}
+
+	return nil
+}
+
+// Get a customer's balance activity over a statement period.
+//
+// The opening and closing balances are derived from the ledger.
+func (c postgresController) GetStatementActivity(ctx context.Context, customerId string, start time.Time, end time.Time) (*payment.StatementActivity, error) {
+	balance, err := c.getBalance(ctx, nil, customerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var opening, closing int64
+	err = c.cl.QueryRow(
+		ctx,
+		`SELECT
+			COALESCE(SUM(CASE WHEN e.created_at < $2 THEN (CASE WHEN e.credit_account_id = a.id THEN e.amount ELSE -e.amount END) ELSE 0 END), 0)::bigint,
+			COALESCE(SUM(CASE WHEN e.created_at < $3 THEN (CASE WHEN e.credit_account_id = a.id THEN e.amount ELSE -e.amount END) ELSE 0 END), 0)::bigint
+		FROM ledger_accounts a
+		JOIN ledger_entries e ON e.credit_account_id = a.id OR e.debit_account_id = a.id
+		WHERE a.code=$1`,
+		customerBalanceAccount(customerId),
+		start,
+		end,
+	).Scan(&opening, &closing)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to calculate statement balances", err)
+	}
+
+	transactions, err := c.getTransactions(ctx, nil, pgTransactionBaseQuery+" WHERE customer_id=$1 AND processed_at >= $2 AND processed_at < $3 ORDER BY id", customerId, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := balance.Balance.GetCurrencyCode()
+	return &payment.StatementActivity{
+		OpeningBalance: money.New(opening, currency),
+		ClosingBalance: money.New(closing, currency),
+		Transactions:   transactions,
+	}, nil
+}
+
+// Store a statement (alongside its rendered documents).
+func (c postgresController) CreateStatement(ctx context.Context, statement *pb.Statement, pdfDoc []byte, csvDoc []byte) (*pb.Statement, error) {
+	var statementId string
+	err := c.cl.QueryRow(
+		ctx,
+		"INSERT INTO statements (customer_id, period_start, period_end, opening_balance, closing_balance, currency, transaction_count, pdf, csv) VALUES ($1, to_timestamp($2) AT TIME ZONE 'utc', to_timestamp($3) AT TIME ZONE 'utc', $4, $5, $6, $7, $8, $9) ON CONFLICT (customer_id, period_start) DO NOTHING RETURNING id",
+		statement.CustomerId,
+		statement.PeriodStart,
+		statement.PeriodEnd,
+		statement.OpeningBalance.GetMinorUnits(),
+		statement.ClosingBalance.GetMinorUnits(),
+		statement.OpeningBalance.GetCurrencyCode(),
+		statement.TransactionCount,
+		pdfDoc,
+		csvDoc,
+	).Scan(&statementId)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.NewServiceError(errors.ErrCodeAlreadyExists, "statement already exists for the period")
+		}
+
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert statement", err)
+	}
+
+	return c.GetStatement(ctx, statementId)
+}
+
+func (c postgresController) GetStatement(ctx context.Context, statementId string) (*pb.Statement, error) {
+	row := c.cl.QueryRow(ctx, pgStatementBaseQuery+" WHERE id=$1", statementId)
+	return scanRowToStatement(row)
+}
+
+// Get a rendered statement document.
+func (c postgresController) GetStatementDocument(ctx context.Context, statementId string, format pb.StatementFormat) ([]byte, error) {
+	var column string
+	switch format {
+	case pb.StatementFormat_STATEMENT_FORMAT_PDF:
+		column = "pdf"
+	case pb.StatementFormat_STATEMENT_FORMAT_CSV:
+		column = "csv"
+	default:
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "unsupported statement format")
+	}
+
+	var document []byte
+	err := c.cl.QueryRow(ctx, "SELECT "+column+" FROM statements WHERE id=$1", statementId).Scan(&document)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "statement not found", err)
+		}
+
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch statement document", err)
+	}
+
+	return document, nil
+}
+
+// Get a customer's statements (most recent first).
+func (c postgresController) GetCustomerStatements(ctx context.Context, customerId string) ([]*pb.Statement, error) {
+	rows, err := c.cl.Query(ctx, pgStatementBaseQuery+" WHERE customer_id=$1 ORDER BY period_start DESC", customerId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching statements", err)
+	}
+	defer rows.Close()
+
+	statements := []*pb.Statement{}
+	for rows.Next() {
+		statement, err := scanRowToStatement(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning statement rows", rows.Err())
+	}
+
+	return statements, nil
+}
+
+// Get the customers (with balances open during the period) that do not yet have a statement for the period.
+func (c postgresController) GetCustomersWithoutStatement(ctx context.Context, start time.Time, end time.Time) ([]string, error) {
+	rows, err := c.cl.Query(
+		ctx,
+		"SELECT b.customer_id FROM customer_balances b WHERE (b.opened_at IS NULL OR b.opened_at < $2) AND NOT EXISTS (SELECT 1 FROM statements s WHERE s.customer_id = b.customer_id AND s.period_start = $1)",
+		start,
+		end,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error whilst fetching customers", err)
+	}
+	defer rows.Close()
+
+	customerIds := []string{}
+	for rows.Next() {
+		var customerId string
+		if err := rows.Scan(&customerId); err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+
+		customerIds = append(customerIds, customerId)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning customer rows", rows.Err())
+	}
+
+	return customerIds, nil
+}
+
+// Scan a postgres row to a protobuf object
+func scanRowToStatement(row pgx.Row) (*pb.Statement, error) {
+	var statement pb.Statement
+
+	// Temporary variables that require conversion
+	var tmpPeriodStart pgtype.Timestamp
+	var tmpPeriodEnd pgtype.Timestamp
+	var tmpOpeningBalance int64
+	var tmpClosingBalance int64
+	var tmpCurrency string
+	var tmpCreatedAt pgtype.Timestamp
+
+	err := row.Scan(
+		&statement.Id,
+		&statement.CustomerId,
+		&tmpPeriodStart,
+		&tmpPeriodEnd,
+		&tmpOpeningBalance,
+		&tmpClosingBalance,
+		&tmpCurrency,
+		&statement.TransactionCount,
+		&tmpCreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "statement not found", err)
+		} else {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+	}
+
+	// Convert the temporary variables
+	// - converting minor unit amounts to money objects
+	statement.OpeningBalance = money.New(tmpOpeningBalance, tmpCurrency)
+	statement.ClosingBalance = money.New(tmpClosingBalance, tmpCurrency)
+
+	// - converting postgres timestamps to unix format
+	if !tmpPeriodStart.Valid || !tmpPeriodEnd.Valid || !tmpCreatedAt.Valid {
+		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to scan object from database (timestamp conversion)")
+	}
+	statement.PeriodStart = tmpPeriodStart.Time.Unix()
+	statement.PeriodEnd = tmpPeriodEnd.Time.Unix()
+	statement.CreatedAt = tmpCreatedAt.Time.Unix()
+
+	return &statement, nil
+}
+
 // Scan a postgres row to a protobuf object
 func scanRowToTransaction(row pgx.Row) (*pb.Transaction, error) {
 	var transaction pb.Transaction
 
 	// Temporary variables that require conversion
+	var tmpAmount int64
+	var tmpCurrency string
+	var tmpChargeAmount int64
+	var tmpChargeCurrency string
+	var tmpRefundedAmount int64
+	var tmpExpiresAt pgtype.Timestamp
+	var tmpCapturedAt pgtype.Timestamp
+	var tmpOrderId pgtype.Text
+	var tmpBalanceAfter pgtype.Int8
+	var tmpBalanceCurrency pgtype.Text
 	var tmpProcessedAt pgtype.Timestamp
 	var tmpReversedAt pgtype.Timestamp
 
 	err := row.Scan(
 		&transaction.Id,
-		&transaction.OrderId,
+		&tmpOrderId,
 		&transaction.CustomerId,
-		&transaction.Amount,
+		&tmpAmount,
+		&tmpCurrency,
+		&tmpChargeAmount,
+		&tmpChargeCurrency,
+		&transaction.ExchangeRate,
+		&tmpRefundedAmount,
+		&transaction.PaymentMethod,
+		&transaction.ProviderReference,
+		&transaction.Status,
+		&tmpExpiresAt,
+		&tmpCapturedAt,
+		&transaction.Type,
+		&tmpBalanceAfter,
+		&tmpBalanceCurrency,
 		&tmpReversedAt,
 		&tmpProcessedAt,
 	)
@@ -423,6 +1518,17 @@ func scanRowToTransaction(row pgx.Row) (*pb.Transaction, error) {
 	}
 
 	// Convert the temporary variables
+	// - converting minor unit amounts to money objects
+	transaction.Amount = money.New(tmpAmount, tmpCurrency)
+	transaction.ChargeAmount = money.New(tmpChargeAmount, tmpChargeCurrency)
+	transaction.RefundedAmount = money.New(tmpRefundedAmount, tmpCurrency)
+	if tmpBalanceAfter.Valid {
+		transaction.BalanceAfter = money.New(tmpBalanceAfter.Int64, tmpBalanceCurrency.String)
+	}
+
+	// - credits are not necessarily associated with an order
+	transaction.OrderId = tmpOrderId.String
+
 	// - converting postgres timestamps to unix format
 	if tmpProcessedAt.Valid {
 		transaction.ProcessedAt = tmpProcessedAt.Time.Unix()
@@ -430,6 +1536,16 @@ func scanRowToTransaction(row pgx.Row) (*pb.Transaction, error) {
 		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to scan object from database (timestamp conversion)")
 	}
 
+	if tmpExpiresAt.Valid {
+		unixExpires := tmpExpiresAt.Time.Unix()
+		transaction.ExpiresAt = &unixExpires
+	}
+
+	if tmpCapturedAt.Valid {
+		unixCaptured := tmpCapturedAt.Time.Unix()
+		transaction.CapturedAt = &unixCaptured
+	}
+
 	if tmpReversedAt.Valid {
 		unixReversed := tmpReversedAt.Time.Unix()
 		transaction.ReversedAt = &unixReversed
@@ -442,9 +1558,18 @@ func scanRowToTransaction(row pgx.Row) (*pb.Transaction, error) {
 func scanRowToCustomerBalance(row pgx.Row) (*pb.CustomerBalance, error) {
 	var balance pb.CustomerBalance
 
+	// Temporary variables that require conversion
+	var tmpBalance int64
+	var tmpHeld int64
+	var tmpCurrency string
+	var tmpOpenedAt pgtype.Timestamp
+
 	err := row.Scan(
 		&balance.CustomerId,
-		&balance.Balance,
+		&tmpBalance,
+		&tmpHeld,
+		&tmpCurrency,
+		&tmpOpenedAt,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -454,5 +1579,24 @@ func scanRowToCustomerBalance(row pgx.Row) (*pb.CustomerBalance, error) {
 		}
 	}
 
+	// Convert the temporary variables
+	balance.Balance = money.New(tmpBalance, tmpCurrency)
+	balance.Held = money.New(tmpHeld, tmpCurrency)
+
+	// - converting postgres timestamps to unix format
+	if tmpOpenedAt.Valid {
+		unixOpened := tmpOpenedAt.Time.Unix()
+		balance.OpenedAt = &unixOpened
+	}
+
 	return &balance, nil
 }
+
+// Convert an empty string to a nil pointer
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+)
+
+func newCapturedPayment(id string, amount int64, refunded int64, currency string, charge int64, chargeCurrency string, rate float64) *pb.Transaction {
+	return &pb.Transaction{
+		Id:             id,
+		Amount:         money.New(amount, currency),
+		ChargeAmount:   money.New(charge, chargeCurrency),
+		ExchangeRate:   rate,
+		RefundedAmount: money.New(refunded, currency),
+		Status:         pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+	}
+}
+
+func assertReversals(t *testing.T, got []transactionReversal, want map[string]int64) {
+	t.Helper()
+
+	if len(got) != len(want) {
+		t.Fatalf("allocateRefund() reversed %d payments, want %d", len(got), len(want))
+	}
+
+	for _, reversal := range got {
+		if reversal.amount.GetMinorUnits() != want[reversal.transactionId] {
+			t.Errorf("allocateRefund() reversed %d from %s, want %d", reversal.amount.GetMinorUnits(), reversal.transactionId, want[reversal.transactionId])
+		}
+	}
+}
+
+func TestAllocateRefund(t *testing.T) {
+	t.Run("refund covered by a single payment", func(t *testing.T) {
+		payments := []*pb.Transaction{newCapturedPayment("1", 3000, 0, "USD", 3000, "USD", 1)}
+
+		reversals, ok := allocateRefund(payments, money.New(1200, "USD"))
+		if !ok {
+			t.Fatalf("allocateRefund() did not cover the refund")
+		}
+		assertReversals(t, reversals, map[string]int64{"1": 1200})
+	})
+
+	t.Run("previous refunds are taken into account", func(t *testing.T) {
+		payments := []*pb.Transaction{newCapturedPayment("1", 3000, 2500, "USD", 3000, "USD", 1)}
+
+		if _, ok := allocateRefund(payments, money.New(1000, "USD")); ok {
+			t.Errorf("allocateRefund() covered a refund exceeding the unrefunded amount")
+		}
+
+		reversals, ok := allocateRefund(payments, money.New(500, "USD"))
+		if !ok {
+			t.Fatalf("allocateRefund() did not cover the refund")
+		}
+		assertReversals(t, reversals, map[string]int64{"1": 500})
+	})
+
+	t.Run("refund split between payments", func(t *testing.T) {
+		payments := []*pb.Transaction{
+			newCapturedPayment("1", 1000, 0, "USD", 1000, "USD", 1),
+			newCapturedPayment("2", 2000, 500, "USD", 2000, "USD", 1),
+			newCapturedPayment("3", 4000, 0, "USD", 4000, "USD", 1),
+		}
+
+		reversals, ok := allocateRefund(payments, money.New(2000, "USD"))
+		if !ok {
+			t.Fatalf("allocateRefund() did not cover the refund")
+		}
+		assertReversals(t, reversals, map[string]int64{"1": 1000, "2": 1000})
+	})
+
+	t.Run("payments converted to the balance currency", func(t *testing.T) {
+		// charged 20.00 USD, taken as 16.00 GBP
+		payments := []*pb.Transaction{newCapturedPayment("1", 1600, 0, "GBP", 2000, "USD", 0.8)}
+
+		reversals, ok := allocateRefund(payments, money.New(1000, "USD"))
+		if !ok {
+			t.Fatalf("allocateRefund() did not cover the refund")
+		}
+		assertReversals(t, reversals, map[string]int64{"1": 800})
+
+		if reversals[0].amount.GetCurrencyCode() != "GBP" {
+			t.Errorf("allocateRefund() reversed in %s, want GBP", reversals[0].amount.GetCurrencyCode())
+		}
+	})
+
+	t.Run("payments charged in another currency are skipped", func(t *testing.T) {
+		payments := []*pb.Transaction{newCapturedPayment("1", 2000, 0, "EUR", 2000, "EUR", 1)}
+
+		reversals, ok := allocateRefund(payments, money.New(1000, "USD"))
+		if ok {
+			t.Errorf("allocateRefund() covered the refund from a payment in another currency")
+		}
+		assertReversals(t, reversals, map[string]int64{})
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+	"github.com/hexolan/stocklet/internal/svc/payment"
+)
+
+// Pays for orders using the customer's stored balance.
+type balancePaymentProvider struct {
+	store payment.StorageController
+}
+
+func NewBalancePaymentProvider(store payment.StorageController) payment.PaymentProvider {
+	return balancePaymentProvider{store: store}
+}
+
+func (p balancePaymentProvider) AuthorizeOrderPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, paymentToken *string, velocityRules []*payment.RiskRule) error {
+	return p.store.AuthorizeOrderPayment(ctx, orderId, customerId, amount, velocityRules)
+}
+
+func (p balancePaymentProvider) CaptureTransaction(ctx context.Context, transaction *pb.Transaction) error {
+	_, err := p.store.CaptureTransaction(ctx, transaction.Id)
+	return err
+}
+
+func (p balancePaymentProvider) VoidTransaction(ctx context.Context, transaction *pb.Transaction, reason eventpb.TransactionVoidedEvent_Reason) error {
+	_, err := p.store.VoidTransaction(ctx, transaction.Id, reason)
+	return err
+}
+
+// Pays for orders by authorizing (and later capturing) a card through the card processor.
+type cardPaymentProvider struct {
+	store     payment.StorageController
+	processor payment.FundingSource
+}
+
+func NewCardPaymentProvider(store payment.StorageController, processor payment.FundingSource) payment.PaymentProvider {
+	return cardPaymentProvider{store: store, processor: processor}
+}
+
+func (p cardPaymentProvider) AuthorizeOrderPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, paymentToken *string, velocityRules []*payment.RiskRule) error {
+	const method = commonpb.PaymentMethod_PAYMENT_METHOD_CARD
+	if paymentToken == nil {
+		return p.store.RecordOrderPaymentFailure(ctx, orderId, customerId, amount, method, commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED)
+	}
+
+	// Authorize the card
+	reference, err := p.processor.Authorize(ctx, customerId, amount, *paymentToken)
+	if err != nil {
+		// check that the error is a result of the card being declined
+		if svcErr, ok := err.(*errors.ServiceError); !ok || svcErr.Code() != errors.ErrCodeInvalidState {
+			return err
+		}
+
+		return p.store.RecordOrderPaymentFailure(ctx, orderId, customerId, amount, method, commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED)
+	}
+
+	// Record the authorization
+	transaction, err := p.store.RecordOrderAuthorization(ctx, orderId, customerId, amount, method, reference, velocityRules)
+	if err != nil || transaction == nil {
+		// Void the authorization as it was not recorded (or was declined by the velocity rules)
+		if voidErr := p.processor.Void(ctx, reference); voidErr != nil {
+			log.Error().Err(voidErr).Str("reference", reference).Msg("failed to void card authorization")
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func (p cardPaymentProvider) CaptureTransaction(ctx context.Context, transaction *pb.Transaction) error {
+	if transaction.ProviderReference == nil {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "transaction has no card authorization")
+	}
+
+	// Claim the transaction for capture
+	// - so that it cannot be voided whilst being captured with the processor
+	err := p.store.TransitionTransaction(ctx, transaction.Id, pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED, pb.TransactionStatus_TRANSACTION_STATUS_CAPTURING)
+	if err != nil {
+		return err
+	}
+
+	// Capture the authorization with the processor
+	err = p.processor.Capture(ctx, *transaction.ProviderReference)
+	if err != nil {
+		p.releaseTransaction(ctx, transaction, pb.TransactionStatus_TRANSACTION_STATUS_CAPTURING)
+		return err
+	}
+
+	_, err = p.store.CaptureTransaction(ctx, transaction.Id)
+	return err
+}
+
+func (p cardPaymentProvider) VoidTransaction(ctx context.Context, transaction *pb.Transaction, reason eventpb.TransactionVoidedEvent_Reason) error {
+	if transaction.ProviderReference == nil {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "transaction has no card authorization")
+	}
+
+	// Claim the transaction for voiding
+	// - so that it cannot be captured whilst being voided with the processor
+	err := p.store.TransitionTransaction(ctx, transaction.Id, pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED, pb.TransactionStatus_TRANSACTION_STATUS_VOIDING)
+	if err != nil {
+		return err
+	}
+
+	// Release the authorization with the processor
+	err = p.processor.Void(ctx, *transaction.ProviderReference)
+	if err != nil {
+		p.releaseTransaction(ctx, transaction, pb.TransactionStatus_TRANSACTION_STATUS_VOIDING)
+		return err
+	}
+
+	_, err = p.store.VoidTransaction(ctx, transaction.Id, reason)
+	return err
+}
+
+// Return a claimed transaction to authorized after the processor failed to capture (or void) it.
+func (p cardPaymentProvider) releaseTransaction(ctx context.Context, transaction *pb.Transaction, claimed pb.TransactionStatus) {
+	err := p.store.TransitionTransaction(ctx, transaction.Id, claimed, pb.TransactionStatus_TRANSACTION_STATUS_AUTHORIZED)
+	if err != nil {
+		log.Error().Err(err).Str("transaction_id", transaction.Id).Msg("failed to release card transaction")
+	}
+}
+
+// Pays for orders using a gift card (with its id held as the payment token),
+// with any remainder paid from the customer's stored balance.
+type giftCardPaymentProvider struct {
+	store payment.StorageController
+}
+
+func NewGiftCardPaymentProvider(store payment.StorageController) payment.PaymentProvider {
+	return giftCardPaymentProvider{store: store}
+}
+
+func (p giftCardPaymentProvider) AuthorizeOrderPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, paymentToken *string, velocityRules []*payment.RiskRule) error {
+	if paymentToken == nil {
+		return p.store.RecordOrderPaymentFailure(ctx, orderId, customerId, amount, commonpb.PaymentMethod_PAYMENT_METHOD_GIFT_CARD, commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED)
+	}
+
+	return p.store.AuthorizeGiftCardPayment(ctx, orderId, customerId, amount, *paymentToken, velocityRules)
+}
+
+// The funds are held from the balance once redeemed from the gift card.
+func (p giftCardPaymentProvider) CaptureTransaction(ctx context.Context, transaction *pb.Transaction) error {
+	_, err := p.store.CaptureTransaction(ctx, transaction.Id)
+	return err
+}
+
+// Voided funds are returned to the balance (rather than the gift card).
+func (p giftCardPaymentProvider) VoidTransaction(ctx context.Context, transaction *pb.Transaction, reason eventpb.TransactionVoidedEvent_Reason) error {
+	_, err := p.store.VoidTransaction(ctx, transaction.Id, reason)
+	return err
+}
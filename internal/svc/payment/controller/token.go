@@ -0,0 +1,115 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+)
+
+// Period that payment tokens are held for (if not cleared once the payment is made)
+const paymentTokenValidity time.Duration = 24 * time.Hour
+
+// Payment tokens (representing the card to charge, or the id of the gift card) are held
+// by the payment service for the duration of the order process. Other services only
+// pass on the reference to the token, which is resolved when the payment is made.
+
+// Hold the payment token of an order.
+//
+// Returns a reference to the token.
+func (c postgresController) HoldPaymentToken(ctx context.Context, orderId string, customerId string, method commonpb.PaymentMethod, paymentToken string) (string, error) {
+	reference, err := generatePaymentTokenReference()
+	if err != nil {
+		return "", err
+	}
+
+	_, err = c.cl.Exec(
+		ctx,
+		"INSERT INTO payment_tokens (reference, order_id, customer_id, payment_method, token, expires_at) VALUES ($1, $2, $3, $4, $5, $6)",
+		reference,
+		orderId,
+		customerId,
+		method,
+		paymentToken,
+		time.Now().UTC().Add(paymentTokenValidity),
+	)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeExtService, "failed to hold payment token", err)
+	}
+
+	return reference, nil
+}
+
+// Resolve the payment token held for an order.
+//
+// Returns nil if the reference does not match an unexpired
+// token held for the order (by the customer, with the payment method).
+func (c postgresController) GetPaymentToken(ctx context.Context, reference string, orderId string, customerId string, method commonpb.PaymentMethod) (*string, error) {
+	var paymentToken string
+	err := c.cl.QueryRow(
+		ctx,
+		"SELECT token FROM payment_tokens WHERE reference=$1 AND order_id=$2 AND customer_id=$3 AND payment_method=$4 AND expires_at > timezone('utc', now())",
+		reference,
+		orderId,
+		customerId,
+		method,
+	).Scan(&paymentToken)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to get payment token", err)
+	}
+
+	return &paymentToken, nil
+}
+
+// Clear any payment tokens held for an order.
+func (c postgresController) ClearPaymentTokens(ctx context.Context, orderId string) error {
+	_, err := c.cl.Exec(ctx, "DELETE FROM payment_tokens WHERE order_id=$1", orderId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to clear payment tokens", err)
+	}
+
+	return nil
+}
+
+// Clear the payment tokens which have passed their expiry.
+func (c postgresController) ClearExpiredPaymentTokens(ctx context.Context) error {
+	_, err := c.cl.Exec(ctx, "DELETE FROM payment_tokens WHERE expires_at <= timezone('utc', now())")
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to clear expired payment tokens", err)
+	}
+
+	return nil
+}
+
+func generatePaymentTokenReference() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeService, "failed to generate payment token reference", err)
+	}
+
+	return "ptr_" + hex.EncodeToString(b), nil
+}
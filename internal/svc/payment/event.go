@@ -17,6 +17,7 @@ package payment
 
 import (
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventspb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
 )
@@ -24,7 +25,7 @@ import (
 func PrepareBalanceCreatedEvent(bal *pb.CustomerBalance) ([]byte, string, error) {
 	topic := messaging.Payment_Balance_Created_Topic
 	event := &eventspb.BalanceCreatedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		CustomerId: bal.CustomerId,
 		Balance:    bal.Balance,
@@ -33,23 +34,27 @@ func PrepareBalanceCreatedEvent(bal *pb.CustomerBalance) ([]byte, string, error)
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareBalanceCreditedEvent(customerId string, amount float32, newBalance float32) ([]byte, string, error) {
+func PrepareBalanceCreditedEvent(customerId string, amount *commonpb.Money, newBalance *commonpb.Money, credit *BalanceCredit) ([]byte, string, error) {
 	topic := messaging.Payment_Balance_Credited_Topic
 	event := &eventspb.BalanceCreditedEvent{
-		Revision: 1,
+		Revision: 2,
 
-		CustomerId: customerId,
-		Amount:     amount,
-		NewBalance: newBalance,
+		CustomerId:       customerId,
+		Amount:           amount,
+		NewBalance:       newBalance,
+		Source:           credit.Source,
+		Reference:        credit.Reference,
+		AdjustmentReason: eventspb.BalanceCreditedEvent_AdjustmentReason(credit.AdjustmentReason),
+		AdjustmentNote:   credit.AdjustmentNote,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareBalanceDebitedEvent(customerId string, amount float32, newBalance float32) ([]byte, string, error) {
+func PrepareBalanceDebitedEvent(customerId string, amount *commonpb.Money, newBalance *commonpb.Money) ([]byte, string, error) {
 	topic := messaging.Payment_Balance_Debited_Topic
 	event := &eventspb.BalanceDebitedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		CustomerId: customerId,
 		Amount:     amount,
@@ -62,7 +67,7 @@ func PrepareBalanceDebitedEvent(customerId string, amount float32, newBalance fl
 func PrepareBalanceClosedEvent(bal *pb.CustomerBalance) ([]byte, string, error) {
 	topic := messaging.Payment_Balance_Closed_Topic
 	event := &eventspb.BalanceClosedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		CustomerId: bal.CustomerId,
 		Balance:    bal.Balance,
@@ -74,7 +79,7 @@ func PrepareBalanceClosedEvent(bal *pb.CustomerBalance) ([]byte, string, error)
 func PrepareTransactionLoggedEvent(transaction *pb.Transaction) ([]byte, string, error) {
 	topic := messaging.Payment_Transaction_Created_Topic
 	event := &eventspb.TransactionLoggedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		TransactionId: transaction.Id,
 		Amount:        transaction.Amount,
@@ -85,10 +90,26 @@ func PrepareTransactionLoggedEvent(transaction *pb.Transaction) ([]byte, string,
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareTransactionReversedEvent(transaction *pb.Transaction) ([]byte, string, error) {
+func PrepareTransactionReversedEvent(transaction *pb.Transaction, amount *commonpb.Money) ([]byte, string, error) {
 	topic := messaging.Payment_Transaction_Reversed_Topic
 	event := &eventspb.TransactionReversedEvent{
-		Revision: 1,
+		Revision: 2,
+
+		TransactionId:  transaction.Id,
+		Amount:         amount,
+		OrderId:        transaction.OrderId,
+		CustomerId:     transaction.CustomerId,
+		RefundedAmount: transaction.RefundedAmount,
+		FullyReversed:  transaction.RefundedAmount.GetMinorUnits() >= transaction.Amount.GetMinorUnits(),
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareTransactionCapturedEvent(transaction *pb.Transaction) ([]byte, string, error) {
+	topic := messaging.Payment_Transaction_Captured_Topic
+	event := &eventspb.TransactionCapturedEvent{
+		Revision: 2,
 
 		TransactionId: transaction.Id,
 		Amount:        transaction.Amount,
@@ -99,30 +120,136 @@ func PrepareTransactionReversedEvent(transaction *pb.Transaction) ([]byte, strin
 	return messaging.MarshalEvent(event, topic)
 }
 
+func PrepareTransactionVoidedEvent(transaction *pb.Transaction, reason eventspb.TransactionVoidedEvent_Reason) ([]byte, string, error) {
+	topic := messaging.Payment_Transaction_Voided_Topic
+	event := &eventspb.TransactionVoidedEvent{
+		Revision: 2,
+
+		TransactionId: transaction.Id,
+		Amount:        transaction.Amount,
+		OrderId:       transaction.OrderId,
+		CustomerId:    transaction.CustomerId,
+		Reason:        reason,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareUnpaidShipmentEvent(orderId string, shipmentId string) ([]byte, string, error) {
+	topic := messaging.Payment_Unpaid_Shipment_Topic
+	event := &eventspb.UnpaidShipmentEvent{
+		Revision: 1,
+
+		OrderId:    orderId,
+		ShipmentId: shipmentId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
 func PreparePaymentProcessedEvent_Success(transaction *pb.Transaction) ([]byte, string, error) {
 	topic := messaging.Payment_Processing_Topic
 	event := &eventspb.PaymentProcessedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		Type:          eventspb.PaymentProcessedEvent_TYPE_SUCCESS,
 		OrderId:       transaction.OrderId,
 		CustomerId:    transaction.CustomerId,
 		Amount:        transaction.Amount,
 		TransactionId: &transaction.Id,
+		ChargeAmount:  transaction.ChargeAmount,
+		ExchangeRate:  transaction.ExchangeRate,
+		PaymentMethod: transaction.PaymentMethod,
+
+		AuthorizationExpiresAt: transaction.ExpiresAt,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PreparePaymentProcessedEvent_Failure(orderId string, customerId string, amount float32) ([]byte, string, error) {
+func PreparePaymentProcessedEvent_Failure(orderId string, customerId string, amount *commonpb.Money, method commonpb.PaymentMethod, riskReason commonpb.PaymentRiskReason) ([]byte, string, error) {
 	topic := messaging.Payment_Processing_Topic
 	event := &eventspb.PaymentProcessedEvent{
-		Revision: 1,
+		Revision: 2,
+
+		Type:          eventspb.PaymentProcessedEvent_TYPE_FAILED,
+		OrderId:       orderId,
+		CustomerId:    customerId,
+		Amount:        amount,
+		ChargeAmount:  amount,
+		PaymentMethod: method,
+		RiskReason:    riskReason,
+	}
 
-		Type:       eventspb.PaymentProcessedEvent_TYPE_FAILED,
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareRefundProcessedEvent_Success(refundId string, returnId string, orderId string, customerId string, amount *commonpb.Money) ([]byte, string, error) {
+	topic := messaging.Payment_Refund_Topic
+	event := &eventspb.RefundProcessedEvent{
+		Revision: 2,
+
+		Type:       eventspb.RefundProcessedEvent_TYPE_SUCCESS,
+		ReturnId:   returnId,
 		OrderId:    orderId,
 		CustomerId: customerId,
 		Amount:     amount,
+		RefundId:   &refundId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareRefundProcessedEvent_Failure(returnId string, orderId string, customerId string, amount *commonpb.Money) ([]byte, string, error) {
+	topic := messaging.Payment_Refund_Topic
+	event := &eventspb.RefundProcessedEvent{
+		Revision: 2,
+
+		Type:       eventspb.RefundProcessedEvent_TYPE_FAILED,
+		ReturnId:   returnId,
+		OrderId:    orderId,
+		CustomerId: customerId,
+		Amount:     amount,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareGiftCardIssuedEvent(giftCard *pb.GiftCard) ([]byte, string, error) {
+	topic := messaging.Payment_Gift_Card_Issued_Topic
+	event := &eventspb.GiftCardIssuedEvent{
+		Revision: 2,
+
+		GiftCardId: giftCard.Id,
+		Value:      giftCard.InitialValue,
+		ExpiresAt:  giftCard.ExpiresAt,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareGiftCardRedeemedEvent(giftCard *pb.GiftCard, customerId string, amount *commonpb.Money, orderId *string) ([]byte, string, error) {
+	topic := messaging.Payment_Gift_Card_Redeemed_Topic
+	event := &eventspb.GiftCardRedeemedEvent{
+		Revision: 2,
+
+		GiftCardId:     giftCard.Id,
+		CustomerId:     customerId,
+		Amount:         amount,
+		RemainingValue: giftCard.RemainingValue,
+		OrderId:        orderId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareGiftCardExpiredEvent(giftCard *pb.GiftCard) ([]byte, string, error) {
+	topic := messaging.Payment_Gift_Card_Expired_Topic
+	event := &eventspb.GiftCardExpiredEvent{
+		Revision: 2,
+
+		GiftCardId:     giftCard.Id,
+		ForfeitedValue: giftCard.RemainingValue,
 	}
 
 	return messaging.MarshalEvent(event, topic)
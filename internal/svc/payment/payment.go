@@ -17,13 +17,16 @@ package payment
 
 import (
 	"context"
+	"time"
 
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/rs/zerolog/log"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	"github.com/hexolan/stocklet/internal/pkg/money"
 	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
@@ -33,8 +36,11 @@ import (
 type PaymentService struct {
 	pb.UnimplementedPaymentServiceServer
 
-	store StorageController
-	pbVal *protovalidate.Validator
+	store     StorageController
+	funding   FundingSource
+	providers map[commonpb.PaymentMethod]PaymentProvider
+	risk      *RiskEngine
+	pbVal     *protovalidate.Validator
 }
 
 // Interface for database methods
@@ -42,13 +48,115 @@ type PaymentService struct {
 type StorageController interface {
 	GetBalance(ctx context.Context, customerId string) (*pb.CustomerBalance, error)
 	GetTransaction(ctx context.Context, transactionId string) (*pb.Transaction, error)
+	CountRecentPayments(ctx context.Context, customerId string, window time.Duration) (int64, error)
+	ListTransactions(ctx context.Context, customerId string, filter *TransactionFilter, pageSize int32, pageToken string) ([]*pb.Transaction, string, error)
+	GetOrderAuthorizations(ctx context.Context, orderId string) ([]*pb.Transaction, error)
+	HasCapturedOrderPayment(ctx context.Context, orderId string) (bool, error)
+	GetExpiredAuthorizations(ctx context.Context) ([]*pb.Transaction, error)
 
-	CreateBalance(ctx context.Context, customerId string) error
-	CreditBalance(ctx context.Context, customerId string, amount float32) error
-	DebitBalance(ctx context.Context, customerId string, amount float32, orderId *string) (*pb.Transaction, error)
+	CreateBalance(ctx context.Context, customerId string, currency string) error
+	TopUpBalance(ctx context.Context, customerId string, amount *commonpb.Money, reference string) (*pb.CustomerBalance, error)
+	AdjustBalance(ctx context.Context, customerId string, amount *commonpb.Money, reason pb.BalanceAdjustmentReason, note *string) (*pb.CustomerBalance, error)
+	DebitBalance(ctx context.Context, customerId string, amount *commonpb.Money, orderId *string) (*pb.Transaction, error)
 	CloseBalance(ctx context.Context, customerId string) error
+	ReconcileBalances(ctx context.Context, repair bool) ([]*BalanceDrift, error)
 
-	PaymentForOrder(ctx context.Context, orderId string, customerId string, amount float32) error
+	AuthorizeOrderPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, velocityRules []*RiskRule) error
+	RecordOrderAuthorization(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, method commonpb.PaymentMethod, reference string, velocityRules []*RiskRule) (*pb.Transaction, error)
+	RecordOrderPaymentFailure(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, method commonpb.PaymentMethod, riskReason commonpb.PaymentRiskReason) error
+	RecordUnpaidShipment(ctx context.Context, orderId string, shipmentId string) error
+	TransitionTransaction(ctx context.Context, transactionId string, from pb.TransactionStatus, to pb.TransactionStatus) error
+	CaptureTransaction(ctx context.Context, transactionId string) (*pb.Transaction, error)
+	VoidTransaction(ctx context.Context, transactionId string, reason eventpb.TransactionVoidedEvent_Reason) (*pb.Transaction, error)
+	RefundReturn(ctx context.Context, returnId string, orderId string, customerId string, amount *commonpb.Money) error
+	RefundTransaction(ctx context.Context, transactionId string, amount *commonpb.Money) (*pb.Transaction, error)
+
+	IssueGiftCard(ctx context.Context, value *commonpb.Money, expiresAt *int64) (*pb.GiftCard, error)
+	GetGiftCard(ctx context.Context, code string) (*pb.GiftCard, error)
+	RedeemGiftCard(ctx context.Context, code string, customerId string) (*pb.GiftCard, *pb.CustomerBalance, error)
+	AuthorizeGiftCardPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, giftCardId string, velocityRules []*RiskRule) error
+	GetExpiredGiftCards(ctx context.Context) ([]*pb.GiftCard, error)
+	ExpireGiftCard(ctx context.Context, giftCardId string) (*pb.GiftCard, error)
+
+	HoldPaymentToken(ctx context.Context, orderId string, customerId string, method commonpb.PaymentMethod, paymentToken string) (string, error)
+	GetPaymentToken(ctx context.Context, reference string, orderId string, customerId string, method commonpb.PaymentMethod) (*string, error)
+	ClearPaymentTokens(ctx context.Context, orderId string) error
+	ClearExpiredPaymentTokens(ctx context.Context) error
+
+	GetStatementActivity(ctx context.Context, customerId string, start time.Time, end time.Time) (*StatementActivity, error)
+	GetStatement(ctx context.Context, statementId string) (*pb.Statement, error)
+	GetStatementDocument(ctx context.Context, statementId string, format pb.StatementFormat) ([]byte, error)
+	GetCustomerStatements(ctx context.Context, customerId string) ([]*pb.Statement, error)
+	GetCustomersWithoutStatement(ctx context.Context, start time.Time, end time.Time) ([]string, error)
+	CreateStatement(ctx context.Context, statement *pb.Statement, pdfDoc []byte, csvDoc []byte) (*pb.Statement, error)
+}
+
+// Criteria for listing a customer's transactions
+type TransactionFilter struct {
+	StartTime *int64
+	EndTime   *int64
+	OrderId   *string
+	Type      pb.TransactionType
+}
+
+// Details of what a balance credit originates from
+type BalanceCredit struct {
+	Source    eventpb.BalanceCreditedEvent_Source
+	Reference *string
+
+	// Optional - The order that the credit relates to
+	OrderId *string
+
+	// Only applicable to adjustments
+	AdjustmentReason pb.BalanceAdjustmentReason
+	AdjustmentNote   *string
+}
+
+// A discrepancy between a cached balance and the amounts derived from the ledger
+type BalanceDrift struct {
+	CustomerId string
+
+	CachedBalance *commonpb.Money
+	LedgerBalance *commonpb.Money
+
+	CachedHeld *commonpb.Money
+	LedgerHeld *commonpb.Money
+}
+
+// Interface for external sources of funds
+// Flexibility for implementing separate funding sources (e.g. card processors, bank transfers, etc)
+type FundingSource interface {
+	// Charge the payment method represented by the token.
+	// Returns a reference for the charge.
+	Charge(ctx context.Context, customerId string, amount *commonpb.Money, paymentToken string) (string, error)
+
+	// Authorize (place a hold on) the payment method represented by the token.
+	// Returns a reference for the authorization.
+	Authorize(ctx context.Context, customerId string, amount *commonpb.Money, paymentToken string) (string, error)
+
+	// Capture the funds of a previous authorization.
+	Capture(ctx context.Context, reference string) error
+
+	// Void a previous charge or authorization (e.g. when the balance could not be credited).
+	Void(ctx context.Context, reference string) error
+}
+
+// Interface for methods of paying for orders
+// Flexibility for implementing separate payment providers (e.g. stored balance, card processors, etc)
+type PaymentProvider interface {
+	// Authorize (hold) payment for an order.
+	// Dispatches a PaymentProcessedEvent with the outcome.
+	//
+	// The payment is declined if it would exceed any of the velocity rules.
+	AuthorizeOrderPayment(ctx context.Context, orderId string, customerId string, amount *commonpb.Money, paymentToken *string, velocityRules []*RiskRule) error
+
+	// Capture the held funds of an authorized transaction.
+	// Dispatches a TransactionCapturedEvent.
+	CaptureTransaction(ctx context.Context, transaction *pb.Transaction) error
+
+	// Release the held funds of an authorized transaction.
+	// Dispatches a TransactionVoidedEvent.
+	VoidTransaction(ctx context.Context, transaction *pb.Transaction, reason eventpb.TransactionVoidedEvent_Reason) error
 }
 
 // Interface for event consumption
@@ -60,7 +168,7 @@ type ConsumerController interface {
 }
 
 // Create the payment service
-func NewPaymentService(cfg *ServiceConfig, store StorageController) *PaymentService {
+func NewPaymentService(cfg *ServiceConfig, store StorageController, funding FundingSource, providers map[commonpb.PaymentMethod]PaymentProvider, risk *RiskEngine) *PaymentService {
 	// Initialise the protobuf validator
 	pbVal, err := protovalidate.New()
 	if err != nil {
@@ -69,8 +177,11 @@ func NewPaymentService(cfg *ServiceConfig, store StorageController) *PaymentServ
 
 	// Initialise the service
 	return &PaymentService{
-		store: store,
-		pbVal: pbVal,
+		store:     store,
+		funding:   funding,
+		providers: providers,
+		risk:      risk,
+		pbVal:     pbVal,
 	}
 }
 
@@ -89,11 +200,63 @@ func (svc PaymentService) ViewTransaction(ctx context.Context, req *pb.ViewTrans
 		return nil, err
 	}
 
+	// Ensure the user is permitted to view the transaction
+	if err := authorizeResourceAccess(ctx, "view this transaction", "transaction", transaction.CustomerId, transaction.Id); err != nil {
+		return nil, err
+	}
+
 	return &pb.ViewTransactionResponse{Transaction: transaction}, nil
 }
 
+func (svc PaymentService) ListTransactions(ctx context.Context, req *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
+	// If the request is through the gateway, then substitute req.CustomerId for current user
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		// ensure user is authenticated
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return nil, err
+		}
+
+		req.CustomerId = claims.Subject
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 20
+	}
+
+	// Attempt to get the transactions from the db
+	transactions, nextPageToken, err := svc.store.ListTransactions(
+		ctx,
+		req.CustomerId,
+		&TransactionFilter{
+			StartTime: req.StartTime,
+			EndTime:   req.EndTime,
+			OrderId:   req.OrderId,
+			Type:      req.Type,
+		},
+		pageSize,
+		req.PageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListTransactionsResponse{Transactions: transactions, NextPageToken: nextPageToken}, nil
+}
+
 func (svc PaymentService) ViewBalance(ctx context.Context, req *pb.ViewBalanceRequest) (*pb.ViewBalanceResponse, error) {
-	// todo: permission checking
+	// Ensure the user is permitted to view the balance
+	if err := authorizeCustomerAccess(ctx, "view this balance", req.CustomerId, req.CustomerId); err != nil {
+		return nil, err
+	}
 
 	// Attempt to get the balance from the db
 	balance, err := svc.store.GetBalance(ctx, req.CustomerId)
@@ -104,8 +267,465 @@ func (svc PaymentService) ViewBalance(ctx context.Context, req *pb.ViewBalanceRe
 	return &pb.ViewBalanceResponse{Balance: balance}, nil
 }
 
+func (svc PaymentService) TopUpBalance(ctx context.Context, req *pb.TopUpBalanceRequest) (*pb.TopUpBalanceResponse, error) {
+	// If the request is through the gateway, then substitute req.CustomerId for current user
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		// ensure user is authenticated
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return nil, err
+		}
+
+		req.CustomerId = claims.Subject
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.Amount.MinorUnits <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: amount must be positive")
+	}
+
+	// Ensure the customer has a balance before charging the funding source
+	if _, err := svc.store.GetBalance(ctx, req.CustomerId); err != nil {
+		return nil, err
+	}
+
+	// Charge the funding source
+	reference, err := svc.funding.Charge(ctx, req.CustomerId, req.Amount, req.PaymentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Credit the balance
+	// Dispatch BalanceCreditedEvent
+	balance, err := svc.store.TopUpBalance(ctx, req.CustomerId, req.Amount, reference)
+	if err != nil {
+		// Void the charge as the balance was not credited
+		if voidErr := svc.funding.Void(ctx, reference); voidErr != nil {
+			log.Error().Err(voidErr).Str("reference", reference).Msg("failed to void funding source charge")
+		}
+
+		return nil, err
+	}
+
+	return &pb.TopUpBalanceResponse{Balance: balance}, nil
+}
+
+func (svc PaymentService) AdjustBalance(ctx context.Context, req *pb.AdjustBalanceRequest) (*pb.AdjustBalanceResponse, error) {
+	// Only admins (users with an elevated role) can adjust balances
+	if err := authorizeElevatedAccess(ctx, "adjust balances", req.CustomerId, req.CustomerId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.Amount.MinorUnits <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: amount must be positive")
+	}
+
+	// Credit the balance
+	// Dispatch BalanceCreditedEvent
+	balance, err := svc.store.AdjustBalance(ctx, req.CustomerId, req.Amount, req.Reason, req.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AdjustBalanceResponse{Balance: balance}, nil
+}
+
+func (svc PaymentService) RefundTransaction(ctx context.Context, req *pb.RefundTransactionRequest) (*pb.RefundTransactionResponse, error) {
+	// Only admins (users with an elevated role) can refund transactions
+	if err := authorizeElevatedAccess(ctx, "refund transactions", "", req.TransactionId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Credit the refund to the customer's balance
+	// Dispatch TransactionReversedEvent
+	transaction, err := svc.store.RefundTransaction(ctx, req.TransactionId, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RefundTransactionResponse{Transaction: transaction}, nil
+}
+
+func (svc PaymentService) EvaluatePaymentRisk(ctx context.Context, req *pb.EvaluatePaymentRiskRequest) (*pb.EvaluatePaymentRiskResponse, error) {
+	// Only support staff (users with an elevated role) can evaluate through the gateway
+	if err := authorizeElevatedAccess(ctx, "evaluate payment risk", req.CustomerId, req.CustomerId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Evaluate the rules (without taking payment)
+	results, reason, err := svc.risk.Evaluate(ctx, req.CustomerId, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EvaluatePaymentRiskResponse{
+		Approved: reason == commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED,
+		Reason:   reason,
+		Results:  results,
+	}, nil
+}
+
+func (svc PaymentService) IssueGiftCard(ctx context.Context, req *pb.IssueGiftCardRequest) (*pb.IssueGiftCardResponse, error) {
+	// Only admins (users with an elevated role) can issue gift cards
+	if err := authorizeElevatedAccess(ctx, "issue gift cards", "", ""); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.Value.MinorUnits <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: value must be positive")
+	} else if req.ExpiresAt != nil && *req.ExpiresAt <= time.Now().Unix() {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: expiry must be in the future")
+	}
+
+	// Issue the gift card
+	// Dispatch GiftCardIssuedEvent
+	giftCard, err := svc.store.IssueGiftCard(ctx, req.Value, req.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.IssueGiftCardResponse{GiftCard: giftCard}, nil
+}
+
+func (svc PaymentService) ViewGiftCard(ctx context.Context, req *pb.ViewGiftCardRequest) (*pb.ViewGiftCardResponse, error) {
+	// Ensure users are authenticated (on gateway requests)
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		if _, err := gwauth.GetGatewayUser(gwMd); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Attempt to get the gift card from the db
+	giftCard, err := svc.store.GetGiftCard(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// Gift cards tied to a customer can only be viewed by that customer
+	if giftCard.CustomerId != nil {
+		if err := authorizeResourceAccess(ctx, "view this gift card", "gift card", *giftCard.CustomerId, giftCard.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ViewGiftCardResponse{GiftCard: giftCard}, nil
+}
+
+func (svc PaymentService) RedeemGiftCard(ctx context.Context, req *pb.RedeemGiftCardRequest) (*pb.RedeemGiftCardResponse, error) {
+	// If the request is through the gateway, then substitute req.CustomerId for current user
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		// ensure user is authenticated
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return nil, err
+		}
+
+		req.CustomerId = claims.Subject
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Redeem the gift card into the balance
+	// Dispatch GiftCardRedeemedEvent and BalanceCreditedEvent
+	giftCard, balance, err := svc.store.RedeemGiftCard(ctx, req.Code, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RedeemGiftCardResponse{GiftCard: giftCard, Balance: balance}, nil
+}
+
+// Periodically expire gift cards which have passed their expiry.
+func (svc PaymentService) RunGiftCardExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			giftCards, err := svc.store.GetExpiredGiftCards(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to fetch expired gift cards")
+				continue
+			}
+
+			for _, giftCard := range giftCards {
+				// Dispatch GiftCardExpiredEvent
+				_, err := svc.store.ExpireGiftCard(ctx, giftCard.Id)
+				if err != nil {
+					log.Error().Err(err).Str("gift_card_id", giftCard.Id).Msg("failed to expire gift card")
+				}
+			}
+		}
+	}
+}
+
+func (svc PaymentService) ViewStatement(ctx context.Context, req *pb.ViewStatementRequest) (*pb.ViewStatementResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Attempt to get the statement from the db
+	statement, err := svc.store.GetStatement(ctx, req.StatementId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the caller is permitted to view the statement
+	if err := authorizeResourceAccess(ctx, "view this statement", "statement", statement.CustomerId, statement.Id); err != nil {
+		return nil, err
+	}
+
+	res := &pb.ViewStatementResponse{Statement: statement}
+	if req.Format != pb.StatementFormat_STATEMENT_FORMAT_UNSPECIFIED {
+		content, err := svc.store.GetStatementDocument(ctx, statement.Id, req.Format)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Content = content
+		if req.Format == pb.StatementFormat_STATEMENT_FORMAT_PDF {
+			res.ContentType = "application/pdf"
+		} else {
+			res.ContentType = "text/csv"
+		}
+	}
+
+	return res, nil
+}
+
+func (svc PaymentService) ListStatements(ctx context.Context, req *pb.ListStatementsRequest) (*pb.ListStatementsResponse, error) {
+	// If the request is through the gateway, then substitute req.CustomerId for current user
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		// ensure user is authenticated
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return nil, err
+		}
+
+		req.CustomerId = claims.Subject
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Attempt to get the statements from the db
+	statements, err := svc.store.GetCustomerStatements(ctx, req.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListStatementsResponse{Statements: statements}, nil
+}
+
+func (svc PaymentService) GenerateStatement(ctx context.Context, req *pb.GenerateStatementRequest) (*pb.GenerateStatementResponse, error) {
+	// Only admins (users with an elevated role) can generate statements on demand
+	if err := authorizeElevatedAccess(ctx, "generate statements", req.CustomerId, req.CustomerId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Ensure the period has ended
+	start, end := StatementPeriod(int(req.Year), time.Month(req.Month))
+	if end.After(time.Now()) {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "statement period has not yet ended")
+	}
+
+	statement, err := svc.generateStatement(ctx, req.CustomerId, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GenerateStatementResponse{Statement: statement}, nil
+}
+
+// Build, render and store a customer's statement for a period.
+func (svc PaymentService) generateStatement(ctx context.Context, customerId string, start time.Time, end time.Time) (*pb.Statement, error) {
+	activity, err := svc.store.GetStatementActivity(ctx, customerId, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	statement := NewStatement(customerId, start, end, activity)
+	csvDoc, err := RenderStatementCSV(statement, activity.Transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.store.CreateStatement(ctx, statement, RenderStatementPDF(statement, activity.Transactions), csvDoc)
+}
+
+// Periodically generate statements for the previous month.
+func (svc PaymentService) RunStatementGeneration(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now().UTC()
+			start, end := StatementPeriod(now.Year(), now.Month()-1)
+
+			customerIds, err := svc.store.GetCustomersWithoutStatement(ctx, start, end)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to fetch customers awaiting statements")
+				continue
+			}
+
+			for _, customerId := range customerIds {
+				_, err := svc.generateStatement(ctx, customerId, start, end)
+				if err != nil {
+					log.Error().Err(err).Str("customer_id", customerId).Msg("failed to generate statement")
+				}
+			}
+		}
+	}
+}
+
+func (svc PaymentService) HoldPaymentToken(ctx context.Context, req *pb.HoldPaymentTokenRequest) (*pb.HoldPaymentTokenResponse, error) {
+	// Payment tokens can only be held by the order service (or users with an elevated role)
+	if err := authorizeElevatedAccess(ctx, "hold payment tokens", req.CustomerId, req.OrderId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Gift cards are held by their id (so that the code itself is not stored)
+	paymentToken := req.PaymentToken
+	if req.PaymentMethod == commonpb.PaymentMethod_PAYMENT_METHOD_GIFT_CARD {
+		giftCard, err := svc.store.GetGiftCard(ctx, req.PaymentToken)
+		if err != nil {
+			if svcErr, ok := err.(*errors.ServiceError); ok && svcErr.Code() == errors.ErrCodeNotFound {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: gift card not found")
+			}
+
+			return nil, err
+		}
+
+		paymentToken = giftCard.Id
+	}
+
+	// Hold the token
+	reference, err := svc.store.HoldPaymentToken(ctx, req.OrderId, req.CustomerId, req.PaymentMethod, paymentToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.HoldPaymentTokenResponse{PaymentReference: reference}, nil
+}
+
+func (svc PaymentService) ReleasePaymentToken(ctx context.Context, req *pb.ReleasePaymentTokenRequest) (*pb.ReleasePaymentTokenResponse, error) {
+	// Payment tokens can only be released by the order service (or users with an elevated role)
+	if err := authorizeElevatedAccess(ctx, "release payment tokens", "", req.OrderId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Clear the tokens held for the order
+	err := svc.store.ClearPaymentTokens(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ReleasePaymentTokenResponse{}, nil
+}
+
+func (svc PaymentService) CheckOrderPayment(ctx context.Context, req *pb.CheckOrderPaymentRequest) (*pb.CheckOrderPaymentResponse, error) {
+	// Order payments can only be checked by the shipping service (or users with an elevated role)
+	if err := authorizeElevatedAccess(ctx, "check order payments", "", req.OrderId); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// The payment is authorized if held (or already captured)
+	authorizations, err := svc.store.GetOrderAuthorizations(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	} else if len(authorizations) > 0 {
+		return &pb.CheckOrderPaymentResponse{Authorized: true}, nil
+	}
+
+	captured, err := svc.store.HasCapturedOrderPayment(ctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CheckOrderPaymentResponse{Authorized: captured}, nil
+}
+
 func (svc PaymentService) ProcessUserCreatedEvent(ctx context.Context, req *eventpb.UserCreatedEvent) (*emptypb.Empty, error) {
-	err := svc.store.CreateBalance(ctx, req.UserId)
+	// Hold the balance in the user's preferred currency
+	currency := req.PreferredCurrency
+	if currency == "" {
+		currency = money.DefaultCurrency
+	}
+
+	err := svc.store.CreateBalance(ctx, req.UserId, currency)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
 	}
@@ -123,7 +743,194 @@ func (svc PaymentService) ProcessUserDeletedEvent(ctx context.Context, req *even
 }
 
 func (svc PaymentService) ProcessShipmentAllocationEvent(ctx context.Context, req *eventpb.ShipmentAllocationEvent) (*emptypb.Empty, error) {
-	err := svc.store.PaymentForOrder(ctx, req.OrderId, req.OrderMetadata.CustomerId, req.OrderMetadata.TotalPrice)
+	// Release any held funds if the shipment allocation is released (the order is cancelled)
+	if req.Type == eventpb.ShipmentAllocationEvent_TYPE_ALLOCATION_RELEASED {
+		err := svc.voidOrderAuthorizations(ctx, req.OrderId, eventpb.TransactionVoidedEvent_REASON_ORDER_CANCELLED)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+		}
+
+		return &emptypb.Empty{}, nil
+	}
+
+	// Filled backorders have already been authorized (with the rest of the order)
+	if req.Type == eventpb.ShipmentAllocationEvent_TYPE_ALLOCATED && req.BackorderReservationId == nil {
+		// Orders are paid from the stored balance unless otherwise specified
+		method := req.OrderMetadata.PaymentMethod
+		if method == commonpb.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED {
+			method = commonpb.PaymentMethod_PAYMENT_METHOD_BALANCE
+		}
+
+		// Resolve the payment token held for the order (if provided)
+		var paymentToken *string
+		if req.OrderMetadata.PaymentReference != nil {
+			var err error
+			paymentToken, err = svc.store.GetPaymentToken(ctx, *req.OrderMetadata.PaymentReference, req.OrderId, req.OrderMetadata.CustomerId, method)
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+			}
+		}
+
+		// Evaluate the payment against the risk rules
+		//
+		// The velocity rules are checked again when the payment is authorized,
+		// as other payments by the customer may be authorized in the meantime.
+		_, riskReason, err := svc.risk.Evaluate(ctx, req.OrderMetadata.CustomerId, req.OrderMetadata.TotalPrice)
+		if err != nil {
+			// The payment cannot be taken without evaluating the rules
+			log.Error().Err(err).Str("order_id", req.OrderId).Msg("failed to evaluate payment risk")
+			err = svc.store.RecordOrderPaymentFailure(ctx, req.OrderId, req.OrderMetadata.CustomerId, req.OrderMetadata.TotalPrice, method, commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED)
+		} else if riskReason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+			// The payment was prevented by a risk rule
+			err = svc.store.RecordOrderPaymentFailure(ctx, req.OrderId, req.OrderMetadata.CustomerId, req.OrderMetadata.TotalPrice, method, riskReason)
+		} else if provider, ok := svc.providers[method]; ok {
+			err = provider.AuthorizeOrderPayment(ctx, req.OrderId, req.OrderMetadata.CustomerId, req.OrderMetadata.TotalPrice, paymentToken, svc.risk.VelocityRules())
+		} else {
+			// The payment method is not supported
+			err = svc.store.RecordOrderPaymentFailure(ctx, req.OrderId, req.OrderMetadata.CustomerId, req.OrderMetadata.TotalPrice, method, commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED)
+		}
+
+		// The payment token is no longer required once the payment has been attempted
+		svc.clearPaymentTokens(ctx, req.OrderId)
+
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc PaymentService) ProcessShipmentDispatchedEvent(ctx context.Context, req *eventpb.ShipmentDispatchedEvent) (*emptypb.Empty, error) {
+	// Capture the held funds for the order
+	// Dispatch TransactionCapturedEvent
+	authorizations, err := svc.store.GetOrderAuthorizations(ctx, req.OrderId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+	}
+
+	if len(authorizations) == 0 {
+		// The payment will have already been captured if another shipment
+		// of the order (e.g. a filled backorder) was dispatched first
+		captured, err := svc.store.HasCapturedOrderPayment(ctx, req.OrderId)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+		} else if !captured {
+			// The goods have been shipped without payment (e.g. the hold expired whilst dispatching)
+			// Dispatch UnpaidShipmentEvent (so that the payment can be recovered)
+			log.Error().Str("order_id", req.OrderId).Str("shipment_id", req.ShipmentId).Msg("shipment dispatched without an authorized payment")
+			err = svc.store.RecordUnpaidShipment(ctx, req.OrderId, req.ShipmentId)
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+			}
+
+			return &emptypb.Empty{}, nil
+		}
+	}
+
+	for _, transaction := range authorizations {
+		provider, ok := svc.providers[transaction.PaymentMethod]
+		if !ok {
+			return nil, errors.NewServiceErrorf(errors.ErrCodeService, "no provider for payment method (%s)", transaction.PaymentMethod)
+		}
+
+		err = provider.CaptureTransaction(ctx, transaction)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc PaymentService) ProcessOrderRejectedEvent(ctx context.Context, req *eventpb.OrderRejectedEvent) (*emptypb.Empty, error) {
+	// Clear the payment token (in case the order was rejected before payment)
+	svc.clearPaymentTokens(ctx, req.OrderId)
+
+	// Release any held funds for the order
+	// Dispatch TransactionVoidedEvent
+	err := svc.voidOrderAuthorizations(ctx, req.OrderId, eventpb.TransactionVoidedEvent_REASON_ORDER_REJECTED)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// Clear any payment tokens held for an order.
+//
+// Failures are logged, as the tokens will otherwise be cleared upon expiry.
+func (svc PaymentService) clearPaymentTokens(ctx context.Context, orderId string) {
+	err := svc.store.ClearPaymentTokens(ctx, orderId)
+	if err != nil {
+		log.Error().Err(err).Str("order_id", orderId).Msg("failed to clear payment tokens")
+	}
+}
+
+// Void the outstanding authorizations for an order.
+func (svc PaymentService) voidOrderAuthorizations(ctx context.Context, orderId string, reason eventpb.TransactionVoidedEvent_Reason) error {
+	authorizations, err := svc.store.GetOrderAuthorizations(ctx, orderId)
+	if err != nil {
+		return err
+	}
+
+	for _, transaction := range authorizations {
+		if err := svc.voidTransaction(ctx, transaction, reason); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (svc PaymentService) voidTransaction(ctx context.Context, transaction *pb.Transaction, reason eventpb.TransactionVoidedEvent_Reason) error {
+	provider, ok := svc.providers[transaction.PaymentMethod]
+	if !ok {
+		return errors.NewServiceErrorf(errors.ErrCodeService, "no provider for payment method (%s)", transaction.PaymentMethod)
+	}
+
+	return provider.VoidTransaction(ctx, transaction, reason)
+}
+
+// Periodically void authorizations which have not been captured before their expiry.
+//
+// Any payment tokens which were held (but never used) past their expiry are also cleared.
+//
+// Blocks until the context is cancelled.
+func (svc PaymentService) RunAuthorizationExpiry(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := svc.store.ClearExpiredPaymentTokens(ctx); err != nil {
+				log.Error().Err(err).Msg("failed to clear expired payment tokens")
+			}
+
+			authorizations, err := svc.store.GetExpiredAuthorizations(ctx)
+			if err != nil {
+				log.Error().Err(err).Msg("failed to fetch expired authorizations")
+				continue
+			}
+
+			for _, transaction := range authorizations {
+				// Dispatch TransactionVoidedEvent
+				err := svc.voidTransaction(ctx, transaction, eventpb.TransactionVoidedEvent_REASON_EXPIRED)
+				if err != nil {
+					log.Error().Err(err).Str("transaction_id", transaction.Id).Msg("failed to expire authorization")
+				}
+			}
+		}
+	}
+}
+
+func (svc PaymentService) ProcessReturnApprovedEvent(ctx context.Context, req *eventpb.ReturnApprovedEvent) (*emptypb.Empty, error) {
+	// Refund the line amounts of the returned items
+	// Dispatch RefundProcessedEvent
+	err := svc.store.RefundReturn(ctx, req.ReturnId, req.OrderId, req.CustomerId, req.RefundAmount)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
 	}
@@ -0,0 +1,240 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/fx"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+)
+
+// Types of risk rule
+const (
+	// Limits the number of payments a customer can make within a window.
+	RiskRuleVelocity string = "velocity"
+
+	// Limits the value of any single order.
+	RiskRuleMaxOrderValue string = "max_order_value"
+
+	// Limits the value of orders from recently opened accounts.
+	RiskRuleNewAccount string = "new_account"
+)
+
+// A rule evaluated against payments before they are taken
+type RiskRule struct {
+	Id   string
+	Type string
+
+	// Only applicable to velocity rules
+	Window      time.Duration
+	MaxPayments int64
+
+	// Only applicable to new account rules
+	AccountAge time.Duration
+
+	// Only applicable to max order value and new account rules
+	MaxAmount *commonpb.Money
+}
+
+// Risk rules are declared in a JSON file
+//
+// The file is in the form of:
+//
+//	{"rules": [
+//		{"id": "hourly-velocity", "type": "velocity", "window": "1h", "max_payments": 5},
+//		{"id": "max-value", "type": "max_order_value", "max_amount": {"minor_units": 250000, "currency_code": "USD"}},
+//		{"id": "new-accounts", "type": "new_account", "account_age": "72h", "max_amount": {"minor_units": 20000, "currency_code": "USD"}}
+//	]}
+type riskRulesFile struct {
+	Rules []riskRuleSpec `json:"rules"`
+}
+
+type riskRuleSpec struct {
+	Id          string          `json:"id"`
+	Type        string          `json:"type"`
+	Window      string          `json:"window"`
+	MaxPayments int64           `json:"max_payments"`
+	AccountAge  string          `json:"account_age"`
+	MaxAmount   *riskRuleAmount `json:"max_amount"`
+}
+
+type riskRuleAmount struct {
+	MinorUnits   int64  `json:"minor_units"`
+	CurrencyCode string `json:"currency_code"`
+}
+
+// Load the risk rules from a file
+func LoadRiskRules(path string) ([]*RiskRule, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to read risk rules file", err)
+	}
+
+	var file riskRulesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "malformed risk rules file", err)
+	}
+
+	rules := []*RiskRule{}
+	for _, spec := range file.Rules {
+		rule := &RiskRule{Id: spec.Id, Type: spec.Type, MaxPayments: spec.MaxPayments}
+		if rule.Id == "" {
+			return nil, errors.NewServiceError(errors.ErrCodeService, "malformed risk rules file: rule missing id")
+		}
+
+		if spec.MaxAmount != nil {
+			rule.MaxAmount = money.New(spec.MaxAmount.MinorUnits, spec.MaxAmount.CurrencyCode)
+		}
+
+		switch rule.Type {
+		case RiskRuleVelocity:
+			rule.Window, err = time.ParseDuration(spec.Window)
+			if err != nil || rule.Window <= 0 || rule.MaxPayments <= 0 {
+				return nil, errors.NewServiceErrorf(errors.ErrCodeService, "malformed risk rules file: invalid velocity rule (%s)", rule.Id)
+			}
+		case RiskRuleMaxOrderValue:
+			if rule.MaxAmount == nil || rule.MaxAmount.CurrencyCode == "" {
+				return nil, errors.NewServiceErrorf(errors.ErrCodeService, "malformed risk rules file: invalid max order value rule (%s)", rule.Id)
+			}
+		case RiskRuleNewAccount:
+			rule.AccountAge, err = time.ParseDuration(spec.AccountAge)
+			if err != nil || rule.AccountAge <= 0 || rule.MaxAmount == nil || rule.MaxAmount.CurrencyCode == "" {
+				return nil, errors.NewServiceErrorf(errors.ErrCodeService, "malformed risk rules file: invalid new account rule (%s)", rule.Id)
+			}
+		default:
+			return nil, errors.NewServiceErrorf(errors.ErrCodeService, "malformed risk rules file: unknown rule type (%s)", rule.Type)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Evaluates payments against the risk rules
+type RiskEngine struct {
+	rules []*RiskRule
+	store StorageController
+	rates fx.RateProvider
+}
+
+func NewRiskEngine(rules []*RiskRule, store StorageController, rates fx.RateProvider) *RiskEngine {
+	return &RiskEngine{rules: rules, store: store, rates: rates}
+}
+
+// Get the rules limiting the number of payments a customer can make.
+//
+// These are enforced by the storage controller within the transaction authorizing
+// a payment, so that concurrent payments by a customer cannot each pass the limits.
+func (e *RiskEngine) VelocityRules() []*RiskRule {
+	rules := []*RiskRule{}
+	for _, rule := range e.rules {
+		if rule.Type == RiskRuleVelocity {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// Evaluate a prospective payment against every rule.
+//
+// Returns the outcome of each rule, and the reason of the first triggered rule
+// (unspecified if the payment is permitted).
+func (e *RiskEngine) Evaluate(ctx context.Context, customerId string, amount *commonpb.Money) ([]*pb.RiskRuleResult, commonpb.PaymentRiskReason, error) {
+	results := []*pb.RiskRuleResult{}
+	reason := commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED
+	for _, rule := range e.rules {
+		result, err := e.evaluateRule(ctx, rule, customerId, amount)
+		if err != nil {
+			return nil, reason, err
+		}
+
+		if result.Triggered && reason == commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+			reason = result.Reason
+		}
+
+		results = append(results, result)
+	}
+
+	return results, reason, nil
+}
+
+func (e *RiskEngine) evaluateRule(ctx context.Context, rule *RiskRule, customerId string, amount *commonpb.Money) (*pb.RiskRuleResult, error) {
+	result := &pb.RiskRuleResult{RuleId: rule.Id}
+
+	switch rule.Type {
+	case RiskRuleVelocity:
+		count, err := e.store.CountRecentPayments(ctx, customerId, rule.Window)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Triggered = count >= rule.MaxPayments
+		result.Reason = commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_VELOCITY_LIMIT
+		result.Detail = fmt.Sprintf("%d of %d payments made within %s", count, rule.MaxPayments, rule.Window)
+	case RiskRuleMaxOrderValue:
+		exceeded, err := e.exceedsAmount(ctx, amount, rule.MaxAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Triggered = exceeded
+		result.Reason = commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_MAX_ORDER_VALUE
+		result.Detail = fmt.Sprintf("maximum order value of %d %s", rule.MaxAmount.GetMinorUnits(), rule.MaxAmount.GetCurrencyCode())
+	case RiskRuleNewAccount:
+		// Customers without a balance are treated as new accounts
+		isNew := true
+		balance, err := e.store.GetBalance(ctx, customerId)
+		if err == nil && (balance.OpenedAt == nil || time.Since(time.Unix(*balance.OpenedAt, 0)) >= rule.AccountAge) {
+			isNew = false
+		}
+
+		exceeded, err := e.exceedsAmount(ctx, amount, rule.MaxAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Triggered = isNew && exceeded
+		result.Reason = commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_NEW_ACCOUNT_LIMIT
+		result.Detail = fmt.Sprintf("maximum order value of %d %s for accounts opened within %s", rule.MaxAmount.GetMinorUnits(), rule.MaxAmount.GetCurrencyCode(), rule.AccountAge)
+	}
+
+	// The reason is only provided for triggered rules
+	if !result.Triggered {
+		result.Reason = commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED
+	}
+
+	return result, nil
+}
+
+// Check if an amount exceeds a limit (converting the amount to the currency of the limit).
+func (e *RiskEngine) exceedsAmount(ctx context.Context, amount *commonpb.Money, limit *commonpb.Money) (bool, error) {
+	converted, _, err := fx.Convert(ctx, e.rates, amount, limit.GetCurrencyCode())
+	if err != nil {
+		return false, errors.WrapServiceError(errors.ErrCodeInvalidState, "failed to convert currency", err)
+	}
+
+	return converted.GetMinorUnits() > limit.GetMinorUnits(), nil
+}
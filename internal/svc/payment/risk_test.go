@@ -0,0 +1,249 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package payment
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+)
+
+// A storage controller stub providing the methods used by the risk engine
+type riskTestStore struct {
+	StorageController
+
+	recentPayments int64
+	countErr       error
+
+	openedAt *int64
+}
+
+func (s riskTestStore) CountRecentPayments(ctx context.Context, customerId string, window time.Duration) (int64, error) {
+	return s.recentPayments, s.countErr
+}
+
+func (s riskTestStore) GetBalance(ctx context.Context, customerId string) (*pb.CustomerBalance, error) {
+	if s.openedAt == nil {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "balance not found")
+	}
+
+	return &pb.CustomerBalance{CustomerId: customerId, OpenedAt: s.openedAt}, nil
+}
+
+// A rate provider with fixed rates to USD
+type riskTestRates map[string]float64
+
+func (r riskTestRates) GetRate(ctx context.Context, from string, to string) (float64, error) {
+	rate, ok := r[from]
+	if !ok || to != "USD" {
+		return 0, errors.NewServiceErrorf(errors.ErrCodeNotFound, "no exchange rate available for %s", from)
+	}
+
+	return rate, nil
+}
+
+var (
+	testVelocityRule   = &RiskRule{Id: "velocity", Type: RiskRuleVelocity, Window: time.Hour, MaxPayments: 5}
+	testMaxValueRule   = &RiskRule{Id: "max-value", Type: RiskRuleMaxOrderValue, MaxAmount: money.New(25000, "USD")}
+	testNewAccountRule = &RiskRule{Id: "new-account", Type: RiskRuleNewAccount, AccountAge: 72 * time.Hour, MaxAmount: money.New(5000, "USD")}
+)
+
+// Evaluate a payment against the rules, expecting the evaluation to succeed
+func evaluateRisk(t *testing.T, store riskTestStore, amount *commonpb.Money, rules ...*RiskRule) ([]*pb.RiskRuleResult, commonpb.PaymentRiskReason) {
+	t.Helper()
+
+	engine := NewRiskEngine(rules, store, riskTestRates{"EUR": 0.5})
+	results, reason, err := engine.Evaluate(context.Background(), "customer", amount)
+	if err != nil {
+		t.Fatalf("Evaluate() unexpected error: %v", err)
+	} else if len(results) != len(rules) {
+		t.Fatalf("Evaluate() returned %d results, want %d", len(results), len(rules))
+	}
+
+	for i, result := range results {
+		if result.RuleId != rules[i].Id {
+			t.Errorf("result %d rule = %s, want %s", i, result.RuleId, rules[i].Id)
+		}
+
+		if !result.Triggered && result.Reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+			t.Errorf("result %d (%s) has a reason without being triggered", i, result.RuleId)
+		}
+	}
+
+	return results, reason
+}
+
+func TestRiskEngineWithoutRules(t *testing.T) {
+	_, reason := evaluateRisk(t, riskTestStore{}, money.New(1000000, "USD"))
+	if reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+		t.Errorf("Evaluate() reason = %v, want unspecified", reason)
+	}
+}
+
+func TestRiskEngineVelocity(t *testing.T) {
+	results, reason := evaluateRisk(t, riskTestStore{recentPayments: 4}, money.New(1000, "USD"), testVelocityRule)
+	if results[0].Triggered || reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_UNSPECIFIED {
+		t.Errorf("velocity rule triggered within the limit")
+	}
+
+	results, reason = evaluateRisk(t, riskTestStore{recentPayments: 5}, money.New(1000, "USD"), testVelocityRule)
+	if !results[0].Triggered || reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_VELOCITY_LIMIT {
+		t.Errorf("velocity rule not triggered once the limit was reached (reason %v)", reason)
+	}
+}
+
+func TestRiskEngineMaxOrderValue(t *testing.T) {
+	// Amounts are compared in the currency of the limit (at 1 EUR to 0.5 USD)
+	for _, tt := range []struct {
+		amount *commonpb.Money
+		want   bool
+	}{
+		{money.New(24999, "USD"), false},
+		{money.New(25000, "USD"), false},
+		{money.New(25001, "USD"), true},
+		{money.New(50000, "EUR"), false},
+		{money.New(50002, "EUR"), true},
+	} {
+		results, reason := evaluateRisk(t, riskTestStore{}, tt.amount, testMaxValueRule)
+		if results[0].Triggered != tt.want {
+			t.Errorf("max order value rule triggered = %v for %d %s, want %v", results[0].Triggered, tt.amount.MinorUnits, tt.amount.CurrencyCode, tt.want)
+		} else if tt.want && reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_MAX_ORDER_VALUE {
+			t.Errorf("Evaluate() reason = %v, want max order value", reason)
+		}
+	}
+}
+
+func TestRiskEngineNewAccount(t *testing.T) {
+	openedRecently := time.Now().Add(-time.Hour).Unix()
+	openedLongAgo := time.Now().Add(-30 * 24 * time.Hour).Unix()
+
+	t.Run("recently opened account over the limit", func(t *testing.T) {
+		results, reason := evaluateRisk(t, riskTestStore{openedAt: &openedRecently}, money.New(6000, "USD"), testNewAccountRule)
+		if !results[0].Triggered || reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_NEW_ACCOUNT_LIMIT {
+			t.Errorf("new account rule not triggered (reason %v)", reason)
+		}
+	})
+
+	t.Run("recently opened account within the limit", func(t *testing.T) {
+		results, _ := evaluateRisk(t, riskTestStore{openedAt: &openedRecently}, money.New(5000, "USD"), testNewAccountRule)
+		if results[0].Triggered {
+			t.Errorf("new account rule triggered within the limit")
+		}
+	})
+
+	t.Run("established account", func(t *testing.T) {
+		results, _ := evaluateRisk(t, riskTestStore{openedAt: &openedLongAgo}, money.New(6000, "USD"), testNewAccountRule)
+		if results[0].Triggered {
+			t.Errorf("new account rule triggered for an established account")
+		}
+	})
+
+	t.Run("customer without a balance", func(t *testing.T) {
+		results, _ := evaluateRisk(t, riskTestStore{}, money.New(6000, "USD"), testNewAccountRule)
+		if !results[0].Triggered {
+			t.Errorf("new account rule not triggered for a customer without a balance")
+		}
+	})
+}
+
+func TestRiskEngineReasonOfFirstTriggeredRule(t *testing.T) {
+	openedRecently := time.Now().Add(-time.Hour).Unix()
+	store := riskTestStore{recentPayments: 10, openedAt: &openedRecently}
+
+	// Every rule is evaluated (for the dry-run results), but the
+	// reason is taken from the first rule that was triggered.
+	results, reason := evaluateRisk(t, store, money.New(30000, "USD"), testMaxValueRule, testVelocityRule, testNewAccountRule)
+	for _, result := range results {
+		if !result.Triggered {
+			t.Errorf("rule %s was not triggered", result.RuleId)
+		}
+	}
+
+	if reason != commonpb.PaymentRiskReason_PAYMENT_RISK_REASON_MAX_ORDER_VALUE {
+		t.Errorf("Evaluate() reason = %v, want max order value", reason)
+	}
+}
+
+func TestRiskEngineErrors(t *testing.T) {
+	ctx := context.Background()
+
+	// Failing to count payments
+	store := riskTestStore{countErr: errors.NewServiceError(errors.ErrCodeExtService, "query error")}
+	_, _, err := NewRiskEngine([]*RiskRule{testVelocityRule}, store, riskTestRates{}).Evaluate(ctx, "customer", money.New(1000, "USD"))
+	if svcErr, ok := err.(*errors.ServiceError); !ok || svcErr.Code() != errors.ErrCodeExtService {
+		t.Errorf("Evaluate() error = %v, want the store error", err)
+	}
+
+	// Failing to convert the amount to the currency of a limit
+	_, _, err = NewRiskEngine([]*RiskRule{testMaxValueRule}, riskTestStore{}, riskTestRates{}).Evaluate(ctx, "customer", money.New(1000, "GBP"))
+	if svcErr, ok := err.(*errors.ServiceError); !ok || svcErr.Code() != errors.ErrCodeInvalidState {
+		t.Errorf("Evaluate() error = %v, want an invalid state error", err)
+	}
+}
+
+func TestLoadRiskRules(t *testing.T) {
+	writeRules := func(t *testing.T, contents string) string {
+		path := filepath.Join(t.TempDir(), "risk-rules.json")
+		if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+			t.Fatalf("failed to write rules file: %v", err)
+		}
+
+		return path
+	}
+
+	rules, err := LoadRiskRules(writeRules(t, `{"rules": [
+		{"id": "hourly-velocity", "type": "velocity", "window": "1h", "max_payments": 5},
+		{"id": "max-value", "type": "max_order_value", "max_amount": {"minor_units": 250000, "currency_code": "USD"}},
+		{"id": "new-accounts", "type": "new_account", "account_age": "72h", "max_amount": {"minor_units": 20000, "currency_code": "USD"}}
+	]}`))
+	if err != nil {
+		t.Fatalf("LoadRiskRules() unexpected error: %v", err)
+	} else if len(rules) != 3 {
+		t.Fatalf("LoadRiskRules() loaded %d rules, want 3", len(rules))
+	}
+
+	if rules[0].Window != time.Hour || rules[0].MaxPayments != 5 {
+		t.Errorf("velocity rule = %+v", *rules[0])
+	}
+
+	if rules[1].MaxAmount.GetMinorUnits() != 250000 || rules[1].MaxAmount.GetCurrencyCode() != "USD" {
+		t.Errorf("max order value rule = %+v", *rules[1])
+	}
+
+	if rules[2].AccountAge != 72*time.Hour || rules[2].MaxAmount.GetMinorUnits() != 20000 {
+		t.Errorf("new account rule = %+v", *rules[2])
+	}
+
+	for _, malformed := range []string{
+		`{"rules": [{"type": "velocity", "window": "1h", "max_payments": 5}]}`,
+		`{"rules": [{"id": "velocity", "type": "velocity", "window": "soon", "max_payments": 5}]}`,
+		`{"rules": [{"id": "velocity", "type": "velocity", "window": "1h"}]}`,
+		`{"rules": [{"id": "max-value", "type": "max_order_value"}]}`,
+		`{"rules": [{"id": "new-accounts", "type": "new_account", "max_amount": {"minor_units": 20000, "currency_code": "USD"}}]}`,
+		`{"rules": [{"id": "unknown", "type": "unknown"}]}`,
+	} {
+		if _, err := LoadRiskRules(writeRules(t, malformed)); err == nil {
+			t.Errorf("LoadRiskRules() accepted malformed rules: %s", malformed)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package payment
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	"github.com/hexolan/stocklet/internal/pkg/pdf"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+)
+
+// A customer's balance activity over a statement period
+type StatementActivity struct {
+	OpeningBalance *commonpb.Money
+	ClosingBalance *commonpb.Money
+	Transactions   []*pb.Transaction
+}
+
+// Get the bounds of a monthly statement period (in UTC).
+func StatementPeriod(year int, month time.Month) (time.Time, time.Time) {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return start, start.AddDate(0, 1, 0)
+}
+
+// Build a statement from a customer's activity over a period.
+func NewStatement(customerId string, start time.Time, end time.Time, activity *StatementActivity) *pb.Statement {
+	return &pb.Statement{
+		CustomerId:       customerId,
+		PeriodStart:      start.Unix(),
+		PeriodEnd:        end.Unix(),
+		OpeningBalance:   activity.OpeningBalance,
+		ClosingBalance:   activity.ClosingBalance,
+		TransactionCount: int32(len(activity.Transactions)),
+	}
+}
+
+// Render a statement as a CSV document.
+//
+// The summary of the statement is followed by a row for each transaction.
+func RenderStatementCSV(statement *pb.Statement, transactions []*pb.Transaction) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+
+	rows := [][]string{
+		{"customer_id", statement.CustomerId},
+		{"period_start", formatStatementTime(statement.PeriodStart)},
+		{"period_end", formatStatementTime(statement.PeriodEnd)},
+		{"currency", statement.OpeningBalance.GetCurrencyCode()},
+		{"opening_balance", formatStatementAmount(statement.OpeningBalance)},
+		{"closing_balance", formatStatementAmount(statement.ClosingBalance)},
+		{},
+		{"processed_at", "transaction_id", "type", "status", "order_id", "amount", "currency", "balance_after"},
+	}
+
+	for _, transaction := range transactions {
+		rows = append(rows, []string{
+			formatStatementTime(transaction.ProcessedAt),
+			transaction.Id,
+			formatTransactionType(transaction.Type),
+			formatTransactionStatus(transaction.Status),
+			transaction.OrderId,
+			formatStatementAmount(transaction.Amount),
+			transaction.Amount.GetCurrencyCode(),
+			formatStatementAmount(transaction.BalanceAfter),
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to render statement", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Render a statement as a PDF document.
+func RenderStatementPDF(statement *pb.Statement, transactions []*pb.Transaction) []byte {
+	doc := pdf.NewDocument("Statement for " + statement.CustomerId)
+	currency := statement.OpeningBalance.GetCurrencyCode()
+
+	doc.AddLine("STATEMENT")
+	doc.AddLine("")
+	doc.AddLine("Customer:        " + statement.CustomerId)
+	doc.AddLine("Period:          " + formatStatementTime(statement.PeriodStart) + " to " + formatStatementTime(statement.PeriodEnd))
+	doc.AddLine("Opening balance: " + formatStatementAmount(statement.OpeningBalance) + " " + currency)
+	doc.AddLine("Closing balance: " + formatStatementAmount(statement.ClosingBalance) + " " + currency)
+	doc.AddLine("")
+
+	header := fmt.Sprintf("%-20s  %-10s  %-9s  %-10s  %14s  %14s", "Date", "Reference", "Type", "Status", "Amount", "Balance")
+	doc.AddLine(header)
+	doc.AddLine(strings.Repeat("-", len(header)))
+
+	if len(transactions) == 0 {
+		doc.AddLine("No transactions within the period.")
+	}
+
+	for _, transaction := range transactions {
+		amount := formatStatementAmount(transaction.Amount)
+		if transaction.Type == pb.TransactionType_TRANSACTION_TYPE_DEBIT {
+			amount = "-" + amount
+		}
+
+		doc.AddLine(fmt.Sprintf(
+			"%-20s  %-10s  %-9s  %-10s  %14s  %14s",
+			formatStatementTime(transaction.ProcessedAt),
+			transaction.Id,
+			formatTransactionType(transaction.Type),
+			formatTransactionStatus(transaction.Status),
+			amount+" "+transaction.Amount.GetCurrencyCode(),
+			formatStatementAmount(transaction.BalanceAfter),
+		))
+	}
+
+	return doc.Bytes()
+}
+
+func formatStatementTime(unix int64) string {
+	return time.Unix(unix, 0).UTC().Format("2006-01-02 15:04:05")
+}
+
+func formatStatementAmount(amount *commonpb.Money) string {
+	if amount == nil {
+		return ""
+	}
+
+	return money.FormatDecimal(amount.GetMinorUnits(), amount.GetCurrencyCode())
+}
+
+func formatTransactionType(txType pb.TransactionType) string {
+	return strings.ToLower(strings.TrimPrefix(txType.String(), "TRANSACTION_TYPE_"))
+}
+
+func formatTransactionStatus(status pb.TransactionStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "TRANSACTION_STATUS_"))
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package payment
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
+)
+
+func TestStatementPeriod(t *testing.T) {
+	for year := 2023; year <= 2024; year++ {
+		for month := time.January; month <= time.December; month++ {
+			start, end := StatementPeriod(year, month)
+
+			// Periods span from the start of the month to the start of the next
+			if start.Year() != year || start.Month() != month || start.Day() != 1 || start.Location() != time.UTC {
+				t.Errorf("StatementPeriod(%d, %s) starts at %v", year, month, start)
+			}
+
+			if next := start.AddDate(0, 1, 0); !end.Equal(next) {
+				t.Errorf("StatementPeriod(%d, %s) ends at %v, want %v", year, month, end, next)
+			}
+		}
+	}
+
+	// Including the end of the year
+	_, end := StatementPeriod(2024, time.December)
+	if !end.Equal(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("StatementPeriod(2024, December) ends at %v", end)
+	}
+}
+
+// Build a statement for June 2024
+func testStatement(customerId string, opening int64, closing int64, currency string) *pb.Statement {
+	start, end := StatementPeriod(2024, time.June)
+	return NewStatement(customerId, start, end, &StatementActivity{
+		OpeningBalance: money.New(opening, currency),
+		ClosingBalance: money.New(closing, currency),
+	})
+}
+
+func testTransactions(currency string) []*pb.Transaction {
+	processedAt := time.Date(2024, time.June, 12, 9, 30, 0, 0, time.UTC).Unix()
+	return []*pb.Transaction{
+		{
+			Id:           "101",
+			OrderId:      "order-1",
+			Type:         pb.TransactionType_TRANSACTION_TYPE_DEBIT,
+			Status:       pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+			Amount:       money.New(2550, currency),
+			BalanceAfter: money.New(7450, currency),
+			ProcessedAt:  processedAt,
+		},
+		{
+			Id:           "102",
+			Type:         pb.TransactionType_TRANSACTION_TYPE_CREDIT,
+			Status:       pb.TransactionStatus_TRANSACTION_STATUS_CAPTURED,
+			Amount:       money.New(1000, currency),
+			BalanceAfter: money.New(8450, currency),
+			ProcessedAt:  processedAt + 3600,
+		},
+	}
+}
+
+func TestRenderStatementCSV(t *testing.T) {
+	statement := testStatement("customer-1", 10000, 8450, "USD")
+	got, err := RenderStatementCSV(statement, testTransactions("USD"))
+	if err != nil {
+		t.Fatalf("RenderStatementCSV() unexpected error: %v", err)
+	}
+
+	want := "customer_id,customer-1\n" +
+		"period_start,2024-06-01 00:00:00\n" +
+		"period_end,2024-07-01 00:00:00\n" +
+		"currency,USD\n" +
+		"opening_balance,100.00\n" +
+		"closing_balance,84.50\n" +
+		"\n" +
+		"processed_at,transaction_id,type,status,order_id,amount,currency,balance_after\n" +
+		"2024-06-12 09:30:00,101,debit,captured,order-1,25.50,USD,74.50\n" +
+		"2024-06-12 10:30:00,102,credit,captured,,10.00,USD,84.50\n"
+	if string(got) != want {
+		t.Errorf("RenderStatementCSV() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestRenderStatementCSVFormatting(t *testing.T) {
+	// Records of the rendered statement (the header and transaction rows are read separately)
+	readRecords := func(t *testing.T, statement *pb.Statement, transactions []*pb.Transaction) [][]string {
+		t.Helper()
+
+		data, err := RenderStatementCSV(statement, transactions)
+		if err != nil {
+			t.Fatalf("RenderStatementCSV() unexpected error: %v", err)
+		}
+
+		reader := csv.NewReader(bytes.NewReader(data))
+		reader.FieldsPerRecord = -1
+		records, err := reader.ReadAll()
+		if err != nil {
+			t.Fatalf("RenderStatementCSV() is not valid CSV: %v", err)
+		}
+
+		return records
+	}
+
+	t.Run("without transactions", func(t *testing.T) {
+		records := readRecords(t, testStatement("customer-1", 10000, 10000, "USD"), nil)
+		if last := records[len(records)-1]; last[0] != "processed_at" {
+			t.Errorf("RenderStatementCSV() ends with %v, want the transactions header", last)
+		}
+	})
+
+	t.Run("currency without minor units", func(t *testing.T) {
+		records := readRecords(t, testStatement("customer-1", 10000, 8450, "JPY"), testTransactions("JPY")[:1])
+		if records[4][1] != "10000" || records[5][1] != "8450" {
+			t.Errorf("RenderStatementCSV() balances = %v, %v, want 10000 and 8450", records[4], records[5])
+		}
+
+		if row := records[len(records)-1]; row[5] != "2550" || row[7] != "7450" {
+			t.Errorf("RenderStatementCSV() transaction = %v, want amounts 2550 and 7450", row)
+		}
+	})
+
+	t.Run("fields are quoted where required", func(t *testing.T) {
+		records := readRecords(t, testStatement("customer, \"one\"", 0, 0, "USD"), nil)
+		if records[0][1] != "customer, \"one\"" {
+			t.Errorf("RenderStatementCSV() customer = %q", records[0][1])
+		}
+	})
+}
+
+func TestRenderStatementPDF(t *testing.T) {
+	tests := []struct {
+		name         string
+		statement    *pb.Statement
+		transactions []*pb.Transaction
+		wantLines    []string
+		wantPages    int
+	}{
+		{
+			name:      "without transactions",
+			statement: testStatement("customer-1", 10000, 10000, "USD"),
+			wantLines: []string{
+				"Customer:        customer-1",
+				"Period:          2024-06-01 00:00:00 to 2024-07-01 00:00:00",
+				"Opening balance: 100.00 USD",
+				"Closing balance: 100.00 USD",
+				"No transactions within the period.",
+			},
+			wantPages: 1,
+		},
+		{
+			name:         "debits are shown as negative amounts",
+			statement:    testStatement("customer-1", 10000, 8450, "USD"),
+			transactions: testTransactions("USD"),
+			wantLines: []string{
+				"Closing balance: 84.50 USD",
+				"2024-06-12 09:30:00   101         debit      captured        -25.50 USD           74.50",
+				"2024-06-12 10:30:00   102         credit     captured         10.00 USD           84.50",
+			},
+			wantPages: 1,
+		},
+		{
+			name:      "special characters are escaped",
+			statement: testStatement("customer (one)", 0, 0, "USD"),
+			wantLines: []string{
+				"Customer:        customer \\(one\\)",
+			},
+			wantPages: 1,
+		},
+		{
+			name:         "long statements span multiple pages",
+			statement:    testStatement("customer-1", 10000, 8450, "USD"),
+			transactions: repeatTransactions(testTransactions("USD"), 40),
+			wantPages:    2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RenderStatementPDF(tt.statement, tt.transactions)
+
+			if !bytes.HasPrefix(got, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(got, []byte("%%EOF\n")) {
+				t.Fatalf("RenderStatementPDF() is not a PDF document")
+			}
+
+			// The cross-reference table must be located at the offset given in the trailer
+			trailer := got[bytes.LastIndex(got, []byte("startxref\n"))+len("startxref\n"):]
+			offset, err := strconv.Atoi(strings.SplitN(string(trailer), "\n", 2)[0])
+			if err != nil || offset >= len(got) || !bytes.HasPrefix(got[offset:], []byte("xref\n")) {
+				t.Errorf("RenderStatementPDF() has an invalid cross-reference offset")
+			}
+
+			for _, line := range tt.wantLines {
+				if !bytes.Contains(got, []byte("("+line+") Tj")) {
+					t.Errorf("RenderStatementPDF() missing line %q", line)
+				}
+			}
+
+			if pages := bytes.Count(got, []byte("/Type /Page ")); pages != tt.wantPages {
+				t.Errorf("RenderStatementPDF() has %d pages, want %d", pages, tt.wantPages)
+			}
+		})
+	}
+}
+
+func repeatTransactions(transactions []*pb.Transaction, count int) []*pb.Transaction {
+	repeated := []*pb.Transaction{}
+	for i := 0; i < count; i++ {
+		repeated = append(repeated, transactions...)
+	}
+
+	return repeated
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"context"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
+)
+
+// Ensure that the caller is permitted to manage the catalogue.
+//
+// Gateway users must hold the admin role. Requests from within
+// the internal network (not through the gateway) are trusted.
+func authorizeAdmin(ctx context.Context, action string) error {
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if !gatewayRequest {
+		return nil
+	}
+
+	// ensure user is authenticated
+	claims, err := gwauth.GetGatewayUser(gwMd)
+	if err != nil {
+		return err
+	}
+
+	if claims.Role != gwauth.RoleAdmin {
+		return errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to "+action)
+	}
+
+	return nil
+}
@@ -16,17 +16,22 @@
 package product
 
 import (
+	"strings"
+
 	"github.com/hexolan/stocklet/internal/pkg/config"
+	"github.com/hexolan/stocklet/internal/pkg/money"
 )
 
 // Order Service Configuration
 type ServiceConfig struct {
 	// Core Configuration
-	Shared config.SharedConfig
+	Shared      config.SharedConfig
+	ServiceOpts ServiceConfigOpts
 
 	// Dynamically loaded configuration
 	Postgres config.PostgresConfig
 	Kafka    config.KafkaConfig
+	FX       config.FXConfig
 }
 
 // load the base service configuration
@@ -38,5 +43,28 @@ func NewServiceConfig() (*ServiceConfig, error) {
 		return nil, err
 	}
 
+	// load the service config opts
+	if err := cfg.ServiceOpts.Load(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// Service specific config options
+type ServiceConfigOpts struct {
+	// Env Var: "BASE_CURRENCY" (optional)
+	// ISO 4217 currency code that products are priced in
+	// Defaults to "USD"
+	BaseCurrency string
+}
+
+// Load the ServiceConfigOpts
+func (opts *ServiceConfigOpts) Load() error {
+	opts.BaseCurrency = money.DefaultCurrency
+	if baseCurrency, err := config.RequireFromEnv("BASE_CURRENCY"); err == nil {
+		opts.BaseCurrency = strings.ToUpper(strings.TrimSpace(baseCurrency))
+	}
+
+	return nil
+}
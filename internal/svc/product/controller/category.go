@@ -0,0 +1,370 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/product/v1"
+	"github.com/hexolan/stocklet/internal/svc/product"
+)
+
+const pgCategoryBaseQuery string = "SELECT id, parent_id, name, created_at, updated_at FROM categories"
+
+// Query for the ids of a category and all of its subcategories
+//
+// UNION (rather than UNION ALL) ensures the recursion terminates should the hierarchy ever contain a cycle.
+const pgCategorySubtreeQuery string = "WITH RECURSIVE subtree AS (SELECT id FROM categories WHERE id=$1 UNION SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id) SELECT id FROM subtree"
+
+func (c postgresController) GetCategory(ctx context.Context, categoryId string) (*pb.Category, error) {
+	return c.getCategory(ctx, nil, categoryId)
+}
+
+func (c postgresController) getCategory(ctx context.Context, tx *pgx.Tx, categoryId string) (*pb.Category, error) {
+	// Determine if a db transaction is being used
+	var row pgx.Row
+	const query = pgCategoryBaseQuery + " WHERE id=$1"
+	if tx == nil {
+		row = c.cl.QueryRow(ctx, query, categoryId)
+	} else {
+		row = (*tx).QueryRow(ctx, query, categoryId)
+	}
+
+	return scanRowToCategory(row)
+}
+
+// Get the subcategories of a category (or the top-level categories if parentId is nil).
+//
+// The product counts include the products listed under any descendant categories.
+func (c postgresController) GetCategorySummaries(ctx context.Context, parentId *string) ([]*pb.CategorySummary, error) {
+	rows, err := c.cl.Query(
+		ctx,
+		`WITH RECURSIVE tree AS (
+			SELECT id AS root_id, id FROM categories WHERE parent_id IS NOT DISTINCT FROM $1::bigint
+			UNION
+			SELECT t.root_id, c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+		)
+		SELECT c.id, c.parent_id, c.name, c.created_at, c.updated_at,
+			(SELECT COUNT(*) FROM products p JOIN tree t ON p.category_id = t.id WHERE t.root_id = c.id),
+			(SELECT COUNT(*) FROM categories s WHERE s.parent_id = c.id)::int
+		FROM categories c
+		WHERE c.parent_id IS NOT DISTINCT FROM $1::bigint
+		ORDER BY c.name`,
+		parentId,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error", err)
+	}
+	defer rows.Close()
+
+	summaries := []*pb.CategorySummary{}
+	for rows.Next() {
+		var summary pb.CategorySummary
+
+		// Temporary variables that require conversion
+		var tmpParentId pgtype.Int8
+		var tmpCreatedAt pgtype.Timestamp
+		var tmpUpdatedAt pgtype.Timestamp
+
+		category := pb.Category{}
+		err := rows.Scan(
+			&category.Id,
+			&tmpParentId,
+			&category.Name,
+			&tmpCreatedAt,
+			&tmpUpdatedAt,
+			&summary.ProductCount,
+			&summary.SubcategoryCount,
+		)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+
+		if err := convertCategoryColumns(&category, tmpParentId, tmpCreatedAt, tmpUpdatedAt); err != nil {
+			return nil, err
+		}
+
+		summary.Category = &category
+		summaries = append(summaries, &summary)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning rows", rows.Err())
+	}
+
+	return summaries, nil
+}
+
+func (c postgresController) CreateCategory(ctx context.Context, name string, parentId *string) (*pb.Category, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ensure the parent category exists
+	if parentId != nil {
+		if _, err := c.getCategory(ctx, &tx, *parentId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Ensure the name is not taken by a sibling category
+	if err := c.ensureCategoryNameAvailable(ctx, tx, name, parentId, nil); err != nil {
+		return nil, err
+	}
+
+	// Insert the category
+	var categoryId string
+	err = tx.QueryRow(ctx, "INSERT INTO categories (parent_id, name) VALUES ($1, $2) RETURNING id", parentId, name).Scan(&categoryId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create category", err)
+	}
+
+	category, err := c.getCategory(ctx, &tx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := product.PrepareCategoryCreatedEvent(category)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", category.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return category, nil
+}
+
+func (c postgresController) UpdateCategory(ctx context.Context, categoryId string, update *product.CategoryUpdate) (*pb.Category, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	category, err := c.getCategory(ctx, &tx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the changes
+	name := category.Name
+	if update.Name != nil {
+		name = *update.Name
+	}
+
+	parentId := category.ParentId
+	if update.SetParent {
+		parentId = update.ParentId
+
+		// Ensure the new parent exists, and is not the category or one of its subcategories
+		if parentId != nil {
+			// Serialise changes to the hierarchy, so that concurrent moves
+			// cannot each pass the check below and together form a cycle
+			_, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock(hashtext('categories.hierarchy'))")
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to lock category hierarchy", err)
+			}
+
+			if _, err := c.getCategory(ctx, &tx, *parentId); err != nil {
+				return nil, err
+			}
+
+			var cyclic bool
+			err = tx.QueryRow(ctx, "SELECT EXISTS ("+pgCategorySubtreeQuery+" WHERE id=$2)", categoryId, *parentId).Scan(&cyclic)
+			if err != nil {
+				return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to check category hierarchy", err)
+			} else if cyclic {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "a category cannot be moved under itself or its subcategories")
+			}
+		}
+	}
+
+	// Ensure the name is not taken by a sibling category
+	if err := c.ensureCategoryNameAvailable(ctx, tx, name, parentId, &categoryId); err != nil {
+		return nil, err
+	}
+
+	// Update the category
+	_, err = tx.Exec(ctx, "UPDATE categories SET name=$1, parent_id=$2, updated_at=timezone('utc', now()) WHERE id=$3", name, parentId, categoryId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update category", err)
+	}
+
+	category, err = c.getCategory(ctx, &tx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := product.PrepareCategoryUpdatedEvent(category)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", category.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return category, nil
+}
+
+func (c postgresController) DeleteCategory(ctx context.Context, categoryId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	category, err := c.getCategory(ctx, &tx, categoryId)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the category is not in use
+	var inUse bool
+	err = tx.QueryRow(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM categories WHERE parent_id=$1) OR EXISTS (SELECT 1 FROM products WHERE category_id=$1)",
+		categoryId,
+	).Scan(&inUse)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to check category usage", err)
+	} else if inUse {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "category still has products or subcategories")
+	}
+
+	// Delete the category
+	_, err = tx.Exec(ctx, "DELETE FROM categories WHERE id=$1", categoryId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to delete category", err)
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := product.PrepareCategoryDeletedEvent(category)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", category.Id, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Ensure that no other category under the same parent has the name.
+func (c postgresController) ensureCategoryNameAvailable(ctx context.Context, tx pgx.Tx, name string, parentId *string, excludeId *string) error {
+	var taken bool
+	err := tx.QueryRow(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM categories WHERE name=$1 AND parent_id IS NOT DISTINCT FROM $2::bigint AND id IS DISTINCT FROM $3::bigint)",
+		name,
+		parentId,
+		excludeId,
+	).Scan(&taken)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to check category name", err)
+	} else if taken {
+		return errors.NewServiceError(errors.ErrCodeAlreadyExists, "a category with that name already exists")
+	}
+
+	return nil
+}
+
+func scanRowToCategory(row pgx.Row) (*pb.Category, error) {
+	var category pb.Category
+
+	// Temporary variables that require conversion
+	var tmpParentId pgtype.Int8
+	var tmpCreatedAt pgtype.Timestamp
+	var tmpUpdatedAt pgtype.Timestamp
+
+	err := row.Scan(
+		&category.Id,
+		&tmpParentId,
+		&category.Name,
+		&tmpCreatedAt,
+		&tmpUpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "category not found", err)
+		} else {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+	}
+
+	if err := convertCategoryColumns(&category, tmpParentId, tmpCreatedAt, tmpUpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+func convertCategoryColumns(category *pb.Category, parentId pgtype.Int8, createdAt pgtype.Timestamp, updatedAt pgtype.Timestamp) error {
+	if parentId.Valid {
+		id := strconv.FormatInt(parentId.Int64, 10)
+		category.ParentId = &id
+	}
+
+	// convert postgres timestamps to unix format
+	if createdAt.Valid {
+		category.CreatedAt = createdAt.Time.Unix()
+	} else {
+		return errors.NewServiceError(errors.ErrCodeUnknown, "failed to scan object from database (timestamp conversion)")
+	}
+
+	if updatedAt.Valid {
+		unixUpdated := updatedAt.Time.Unix()
+		category.UpdatedAt = &unixUpdated
+	}
+
+	return nil
+}
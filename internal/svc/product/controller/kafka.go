@@ -48,6 +48,14 @@ func NewKafkaController(cl *kgo.Client) product.ConsumerController {
 		messaging.Product_State_Created_Topic,
 		messaging.Product_State_Deleted_Topic,
 		messaging.Product_Attribute_Price_Topic,
+		messaging.Product_Attribute_Details_Topic,
+		messaging.Product_Attribute_Category_Topic,
+		messaging.Product_Variant_Created_Topic,
+		messaging.Product_Variant_Updated_Topic,
+		messaging.Product_Variant_Deleted_Topic,
+		messaging.Product_Category_Created_Topic,
+		messaging.Product_Category_Updated_Topic,
+		messaging.Product_Category_Deleted_Topic,
 		messaging.Product_PriceQuotation_Topic,
 
 		messaging.Order_State_Created_Topic,
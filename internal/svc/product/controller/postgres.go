@@ -17,28 +17,39 @@ package controller
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
 
 	"golang.org/x/exp/maps"
 
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/fx"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/product/v1"
 	"github.com/hexolan/stocklet/internal/svc/product"
 )
 
-const pgProductBaseQuery string = "SELECT id, name, description, price, created_at, updated_at FROM products"
+const pgProductBaseQuery string = "SELECT id, name, description, price, currency, created_at, updated_at, category_id, tags FROM products"
 
 type postgresController struct {
-	cl *pgxpool.Pool
+	cl          *pgxpool.Pool
+	serviceOpts *product.ServiceConfigOpts
+	rates       fx.RateProvider
 }
 
-func NewPostgresController(cl *pgxpool.Pool) product.StorageController {
-	return postgresController{cl: cl}
+func NewPostgresController(cl *pgxpool.Pool, serviceOpts *product.ServiceConfigOpts, rates fx.RateProvider) product.StorageController {
+	return postgresController{cl: cl, serviceOpts: serviceOpts, rates: rates}
 }
 
 func (c postgresController) GetProduct(ctx context.Context, productId string) (*pb.Product, error) {
@@ -65,31 +76,215 @@ func (c postgresController) getProduct(ctx context.Context, tx *pgx.Tx, productI
 }
 
 // todo: implementing pagination mechanism
-func (c postgresController) GetProducts(ctx context.Context) ([]*pb.Product, error) {
-	rows, err := c.cl.Query(ctx, pgProductBaseQuery+" LIMIT 10")
+// Position of the last product in a page of results
+type productPageCursor struct {
+	SortBy pb.ProductSortField `json:"s"`
+	Value  string              `json:"v,omitempty"`
+	Id     int64               `json:"id"`
+}
+
+func (c postgresController) GetProducts(ctx context.Context, filter *product.ProductFilter, pageSize int32, pageToken string) ([]*pb.Product, string, error) {
+	query := goqu.Dialect("postgres").From("products").Select("id", "name", "description", "price", "currency", "created_at", "updated_at", "category_id", "tags")
+
+	// Apply the filters
+	if filter.CategoryId != nil {
+		query = query.Where(goqu.L("category_id IN (WITH RECURSIVE subtree AS (SELECT id FROM categories WHERE id=? UNION SELECT c.id FROM categories c JOIN subtree s ON c.parent_id = s.id) SELECT id FROM subtree)", *filter.CategoryId))
+	}
+
+	if filter.Tag != nil {
+		query = query.Where(goqu.L("? = ANY(tags)", *filter.Tag))
+	}
+
+	if filter.MinPrice != nil {
+		query = query.Where(goqu.C("price").Gte(*filter.MinPrice))
+	}
+
+	if filter.MaxPrice != nil {
+		query = query.Where(goqu.C("price").Lte(*filter.MaxPrice))
+	}
+
+	// Determine the sort expression (with the id as a tiebreaker)
+	// - created_at is compared to the second (as exposed on products)
+	var sortExpr exp.LiteralExpression
+	switch filter.SortBy {
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_CREATED_AT:
+		sortExpr = goqu.L("date_trunc('second', created_at)")
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_PRICE:
+		sortExpr = goqu.L("price")
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_NAME:
+		sortExpr = goqu.L("name")
+	}
+
+	// Continue from the previous page
+	if pageToken != "" {
+		cursor, err := decodeProductPageCursor(pageToken, filter.SortBy)
+		if err != nil {
+			return nil, "", err
+		}
+
+		idCond := goqu.C("id").Gt(cursor.Id)
+		if filter.Descending {
+			idCond = goqu.C("id").Lt(cursor.Id)
+		}
+
+		if sortExpr == nil {
+			query = query.Where(idCond)
+		} else {
+			value, err := productPageCursorValue(cursor)
+			if err != nil {
+				return nil, "", err
+			}
+
+			sortCond := sortExpr.Gt(value)
+			if filter.Descending {
+				sortCond = sortExpr.Lt(value)
+			}
+
+			query = query.Where(goqu.Or(sortCond, goqu.And(sortExpr.Eq(value), idCond)))
+		}
+	}
+
+	// Order the results
+	if sortExpr != nil {
+		if filter.Descending {
+			query = query.Order(sortExpr.Desc(), goqu.C("id").Desc())
+		} else {
+			query = query.Order(sortExpr.Asc(), goqu.C("id").Asc())
+		}
+	} else if filter.Descending {
+		query = query.Order(goqu.C("id").Desc())
+	} else {
+		query = query.Order(goqu.C("id").Asc())
+	}
+
+	// An additional product is fetched to determine if there is a further page
+	statement, args, err := query.Limit(uint(pageSize) + 1).Prepared(true).ToSQL()
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error", err)
+		return nil, "", errors.WrapServiceError(errors.ErrCodeService, "failed to build statement", err)
 	}
 
+	rows, err := c.cl.Query(ctx, statement, args...)
+	if err != nil {
+		return nil, "", errors.WrapServiceError(errors.ErrCodeService, "query error", err)
+	}
+	defer rows.Close()
+
 	products := []*pb.Product{}
 	for rows.Next() {
 		productObj, err := scanRowToProduct(rows)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 
 		products = append(products, productObj)
 	}
 
 	if rows.Err() != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning rows", rows.Err())
+		return nil, "", errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning rows", rows.Err())
+	}
+
+	nextPageToken := ""
+	if len(products) > int(pageSize) {
+		products = products[:pageSize]
+		nextPageToken, err = encodeProductPageCursor(products[pageSize-1], filter.SortBy)
+		if err != nil {
+			return nil, "", err
+		}
 	}
 
-	return products, nil
+	return products, nextPageToken, nil
 }
 
-// Update a product price.
-func (c postgresController) UpdateProductPrice(ctx context.Context, productId string, price float32) (*pb.Product, error) {
+// Search products by name and description using full-text search.
+//
+// Results are ranked by relevance (with matches in the name weighted above
+// those in the description). The final search term is matched as a prefix.
+func (c postgresController) SearchProducts(ctx context.Context, query string, pageSize int32, pageToken string) ([]*pb.ProductSearchResult, string, error) {
+	tsQuery := buildProductSearchQuery(query)
+	if tsQuery == "" {
+		return nil, "", errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: query must contain a search term")
+	}
+
+	// Continue from the previous page
+	var offset int64
+	if pageToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil {
+			return nil, "", errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+		}
+
+		offset, err = strconv.ParseInt(string(decoded), 10, 64)
+		if err != nil || offset < 0 {
+			return nil, "", errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+		}
+	}
+
+	// An additional product is fetched to determine if there is a further page
+	rows, err := c.cl.Query(
+		ctx,
+		`SELECT p.id, p.name, p.description, p.price, p.currency, p.created_at, p.updated_at, p.category_id, p.tags,
+			ts_rank(p.search_vector, q),
+			ts_headline('english', p.name, q, 'StartSel=<b>, StopSel=</b>, HighlightAll=true'),
+			ts_headline('english', p.description, q, 'StartSel=<b>, StopSel=</b>, MaxWords=24, MinWords=8, MaxFragments=2')
+		FROM products p, to_tsquery('english', $1) q
+		WHERE p.search_vector @@ q
+		ORDER BY 10 DESC, p.id
+		LIMIT $2 OFFSET $3`,
+		tsQuery,
+		pageSize+1,
+		offset,
+	)
+	if err != nil {
+		return nil, "", errors.WrapServiceError(errors.ErrCodeService, "query error", err)
+	}
+	defer rows.Close()
+
+	results := []*pb.ProductSearchResult{}
+	for rows.Next() {
+		result, err := scanRowToProductSearchResult(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		results = append(results, result)
+	}
+
+	if rows.Err() != nil {
+		return nil, "", errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning rows", rows.Err())
+	}
+
+	nextPageToken := ""
+	if len(results) > int(pageSize) {
+		results = results[:pageSize]
+		nextPageToken = base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset+int64(pageSize), 10)))
+	}
+
+	return results, nextPageToken, nil
+}
+
+// Build a tsquery matching all of the terms in a search query.
+//
+// Terms are reduced to letters and digits (so the query is always valid)
+// and the final term is matched as a prefix (e.g. "red sho" matches "red shoes").
+func buildProductSearchQuery(query string) string {
+	terms := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	if len(terms) == 0 {
+		return ""
+	}
+
+	terms[len(terms)-1] += ":*"
+	return strings.Join(terms, " & ")
+}
+
+func (c postgresController) CreateProduct(ctx context.Context, name string, description string, price *commonpb.Money, categoryId *string, tags []string, sku *string) (*pb.Product, error) {
+	// Ensure the price is in the base currency
+	if err := c.validatePrice(price); err != nil {
+		return nil, err
+	}
+
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -97,20 +292,41 @@ func (c postgresController) UpdateProductPrice(ctx context.Context, productId st
 	}
 	defer tx.Rollback(ctx)
 
-	// Update product price
-	_, err = tx.Exec(ctx, "UPDATE products SET price=$1 WHERE id=$2", price, productId)
+	// Ensure the category exists
+	if categoryId != nil {
+		if _, err := c.getCategory(ctx, &tx, *categoryId); err != nil {
+			return nil, err
+		}
+	}
+
+	if tags == nil {
+		tags = []string{}
+	}
+
+	// Insert the product
+	var productId string
+	err = tx.QueryRow(
+		ctx,
+		"INSERT INTO products (name, description, price, currency, category_id, tags) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id",
+		name,
+		description,
+		price.GetMinorUnits(),
+		price.GetCurrencyCode(),
+		categoryId,
+		tags,
+	).Scan(&productId)
 	if err != nil {
-		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product price", err)
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create product", err)
 	}
 
-	// Get updated product
+	// Get the created product
 	productObj, err := c.getProduct(ctx, &tx, productId)
 	if err != nil {
 		return nil, err
 	}
 
 	// Add the event to the outbox table with the transaction
-	evt, evtTopic, err := product.PrepareProductPriceUpdatedEvent(productObj)
+	evt, evtTopic, err := product.PrepareProductCreatedEvent(productObj)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
@@ -120,6 +336,150 @@ func (c postgresController) UpdateProductPrice(ctx context.Context, productId st
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
 
+	// Create the default variant (priced as the product)
+	defaultSku := "PRD-" + productObj.Id
+	if sku != nil {
+		defaultSku = *sku
+	}
+
+	_, err = c.createProductVariant(ctx, tx, productObj.Id, defaultSku, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return productObj, nil
+}
+
+func (c postgresController) UpdateProduct(ctx context.Context, productId string, update *product.ProductUpdate) (*pb.Product, error) {
+	// Determine the columns to update
+	record := goqu.Record{"updated_at": goqu.L("timezone('utc', now())")}
+	if update.Name != nil {
+		record["name"] = *update.Name
+	}
+
+	if update.Description != nil {
+		record["description"] = *update.Description
+	}
+
+	if update.Price != nil {
+		// Ensure the price is in the base currency
+		if err := c.validatePrice(update.Price); err != nil {
+			return nil, err
+		}
+
+		record["price"] = update.Price.GetMinorUnits()
+		record["currency"] = update.Price.GetCurrencyCode()
+	}
+
+	if update.SetCategory {
+		record["category_id"] = update.CategoryId
+	}
+
+	statement, args, err := goqu.Dialect("postgres").Update("products").Set(record).Where(goqu.C("id").Eq(productId)).Prepared(true).ToSQL()
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to build statement", err)
+	}
+
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ensure the category exists
+	if update.SetCategory && update.CategoryId != nil {
+		if _, err := c.getCategory(ctx, &tx, *update.CategoryId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update the product
+	result, err := tx.Exec(ctx, statement, args...)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "product not found")
+	}
+
+	// Replace the tags (arrays are set separately as goqu expands slices into lists)
+	if update.SetTags {
+		tags := update.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+
+		_, err = tx.Exec(ctx, "UPDATE products SET tags=$1 WHERE id=$2", tags, productId)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product", err)
+		}
+	}
+
+	// Get updated product
+	productObj, err := c.getProduct(ctx, &tx, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the events to the outbox table with the transaction
+	if update.Name != nil || update.Description != nil || update.SetTags {
+		evt, evtTopic, err := product.PrepareProductDetailsUpdatedEvent(productObj)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+		}
+
+		_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", productObj.Id, evtTopic, evt)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+		}
+	}
+
+	if update.Price != nil {
+		evt, evtTopic, err := product.PrepareProductPriceUpdatedEvent(productObj)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+		}
+
+		_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", productObj.Id, evtTopic, evt)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+		}
+
+		// Variants without a price override take the new price
+		variants, err := c.getProductVariants(ctx, &tx, productObj.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, variant := range variants {
+			if variant.PriceOverridden {
+				continue
+			}
+
+			if err := c.addVariantUpdatedEvent(ctx, tx, variant); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if update.SetCategory {
+		evt, evtTopic, err := product.PrepareProductCategoryUpdatedEvent(productObj)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+		}
+
+		_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", productObj.Id, evtTopic, evt)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+		}
+	}
+
 	// Commit the transaction
 	err = tx.Commit(ctx)
 	if err != nil {
@@ -129,7 +489,6 @@ func (c postgresController) UpdateProductPrice(ctx context.Context, productId st
 	return productObj, nil
 }
 
-// Delete a product by its specified id.
 func (c postgresController) DeleteProduct(ctx context.Context, productId string) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
@@ -170,7 +529,10 @@ func (c postgresController) DeleteProduct(ctx context.Context, productId string)
 	return nil
 }
 
-func (c postgresController) PriceOrderProducts(ctx context.Context, orderId string, customerId string, productQuantities map[string]int32) error {
+// Quote the prices of the product variants in an order.
+//
+// Products are priced in the base currency, with the quote converted to the currency that the order is charged in.
+func (c postgresController) PriceOrderProducts(ctx context.Context, orderId string, customerId string, variantQuantities map[string]int32, currency string) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -178,9 +540,16 @@ func (c postgresController) PriceOrderProducts(ctx context.Context, orderId stri
 	}
 	defer tx.Rollback(ctx)
 
-	// Get prices of all specified products (in productQuantities)
-	productIds := maps.Keys(productQuantities)
-	statement, args, err := goqu.Dialect("postgres").From("products").Select("id", "price").Where(goqu.C("id").In(productIds)).Prepared(true).ToSQL()
+	// Get prices of all specified variants (in variantQuantities)
+	// Variants without a price override take the price of their product
+	variantIds := maps.Keys(variantQuantities)
+	statement, args, err := goqu.Dialect("postgres").
+		From(goqu.T("product_variants").As("v")).
+		Join(goqu.T("products").As("p"), goqu.On(goqu.I("p.id").Eq(goqu.I("v.product_id")))).
+		Select("v.id", "v.product_id", goqu.COALESCE(goqu.I("v.price"), goqu.I("p.price")), "p.currency").
+		Where(goqu.I("v.id").In(variantIds)).
+		Prepared(true).
+		ToSQL()
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to build statement", err)
 	}
@@ -190,52 +559,67 @@ func (c postgresController) PriceOrderProducts(ctx context.Context, orderId stri
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch price quotes", err)
 	}
 
-	var productPrices map[string]float32
+	variantPrices := make(map[string]*commonpb.Money)
+	variantProducts := make(map[string]string)
 	for rows.Next() {
+		var variantId string
 		var productId string
-		var productPrice float32
-		err := rows.Scan(&productId, &productPrice)
+		var variantPrice int64
+		var variantCurrency string
+		err := rows.Scan(&variantId, &productId, &variantPrice, &variantCurrency)
 		if err != nil {
 			return errors.WrapServiceError(errors.ErrCodeNotFound, "failed to fetch price quotes: error whilst scanning row", err)
 		}
 
-		productPrices[productId] = productPrice
+		variantPrices[variantId] = money.New(variantPrice, variantCurrency)
+		variantProducts[variantId] = productId
 	}
 
 	if rows.Err() != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to fetch price quotes: error whilst scanning rows", rows.Err())
 	}
 
-	// Calculate total price
-	// Also ensuring that all items in the itemQuantities have a fetched price
-	var totalPrice float32
-	for productId, quantity := range productQuantities {
-		productPrice, ok := productPrices[productId]
-		if !ok {
-			// Prepare and dispatch failure product pricing event
-			evt, evtTopic, err := product.PrepareProductPriceQuoteEvent_Unavailable(orderId)
-			if err != nil {
-				return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
-			}
+	// Determine the exchange rate from the base currency to the charged currency
+	baseCurrency := c.serviceOpts.BaseCurrency
+	if currency == "" {
+		currency = baseCurrency
+	}
 
-			_, err = c.cl.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderId, evtTopic, evt)
-			if err != nil {
-				return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
-			}
+	exchangeRate := 1.0
+	if currency != baseCurrency {
+		exchangeRate, err = c.rates.GetRate(ctx, baseCurrency, currency)
+		if err != nil {
+			// Unable to quote in the requested currency
+			return c.rejectPriceQuote(ctx, orderId)
+		}
+	}
 
-			return nil
+	// Calculate total price (in the charged currency)
+	// Also ensuring that all items in the variantQuantities have a fetched price (in the base currency)
+	quotedPrices := make(map[string]*commonpb.Money)
+	totalPrice := money.New(0, currency)
+	for variantId, quantity := range variantQuantities {
+		variantPrice, ok := variantPrices[variantId]
+		if !ok || variantPrice.CurrencyCode != baseCurrency {
+			return c.rejectPriceQuote(ctx, orderId)
 		}
 
+		// Convert the unit price to the charged currency
+		quotedPrices[variantId] = money.New(money.Convert(variantPrice.MinorUnits, baseCurrency, currency, exchangeRate), currency)
+
 		// Add to total price
-		totalPrice += productPrice * float32(quantity)
+		totalPrice.MinorUnits += quotedPrices[variantId].MinorUnits * int64(quantity)
 	}
 
 	// Prepare and dispatch successful product pricing event
 	evt, evtTopic, err := product.PrepareProductPriceQuoteEvent_Available(
 		orderId,
-		productQuantities,
-		productPrices,
+		variantQuantities,
+		quotedPrices,
+		variantProducts,
 		totalPrice,
+		baseCurrency,
+		exchangeRate,
 	)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
@@ -255,21 +639,165 @@ func (c postgresController) PriceOrderProducts(ctx context.Context, orderId stri
 	return nil
 }
 
+// Products are priced in the base currency (converted when quoting for orders).
+func (c postgresController) validatePrice(price *commonpb.Money) error {
+	if price.GetCurrencyCode() != c.serviceOpts.BaseCurrency {
+		return errors.NewServiceError(errors.ErrCodeInvalidArgument, "price must be in the base currency ("+c.serviceOpts.BaseCurrency+")")
+	}
+
+	return nil
+}
+
+// Prepare and dispatch failure product pricing event
+func (c postgresController) rejectPriceQuote(ctx context.Context, orderId string) error {
+	evt, evtTopic, err := product.PrepareProductPriceQuoteEvent_Unavailable(orderId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = c.cl.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", orderId, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return nil
+}
+
+// Encode the position of a product (within the sorted results) as a page token
+func encodeProductPageCursor(productObj *pb.Product, sortBy pb.ProductSortField) (string, error) {
+	id, err := strconv.ParseInt(productObj.Id, 10, 64)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeService, "failed to create page token", err)
+	}
+
+	cursor := productPageCursor{SortBy: sortBy, Id: id}
+	switch sortBy {
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_CREATED_AT:
+		cursor.Value = strconv.FormatInt(productObj.CreatedAt, 10)
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_PRICE:
+		cursor.Value = strconv.FormatInt(productObj.Price.GetMinorUnits(), 10)
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_NAME:
+		cursor.Value = productObj.Name
+	}
+
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", errors.WrapServiceError(errors.ErrCodeService, "failed to create page token", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// Decode a page token (ensuring it was issued for the same sort order)
+func decodeProductPageCursor(pageToken string, sortBy pb.ProductSortField) (*productPageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+	}
+
+	var cursor productPageCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.SortBy != sortBy {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+	}
+
+	return &cursor, nil
+}
+
+// Convert the sort value of a cursor to the type of the sort expression.
+func productPageCursorValue(cursor *productPageCursor) (any, error) {
+	switch cursor.SortBy {
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_CREATED_AT:
+		unix, err := strconv.ParseInt(cursor.Value, 10, 64)
+		if err != nil {
+			return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+		}
+
+		return goqu.L("to_timestamp(?) AT TIME ZONE 'utc'", unix), nil
+	case pb.ProductSortField_PRODUCT_SORT_FIELD_PRICE:
+		price, err := strconv.ParseInt(cursor.Value, 10, 64)
+		if err != nil {
+			return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid page token")
+		}
+
+		return price, nil
+	default:
+		return cursor.Value, nil
+	}
+}
+
+func scanRowToProductSearchResult(row pgx.Row) (*pb.ProductSearchResult, error) {
+	var result pb.ProductSearchResult
+	var productObj pb.Product
+
+	// Temporary variables that require conversion
+	var tmpPrice int64
+	var tmpCurrency string
+	var tmpCreatedAt pgtype.Timestamp
+	var tmpUpdatedAt pgtype.Timestamp
+	var tmpCategoryId pgtype.Int8
+
+	err := row.Scan(
+		&productObj.Id,
+		&productObj.Name,
+		&productObj.Description,
+		&tmpPrice,
+		&tmpCurrency,
+		&tmpCreatedAt,
+		&tmpUpdatedAt,
+		&tmpCategoryId,
+		&productObj.Tags,
+		&result.Rank,
+		&result.NameHighlight,
+		&result.DescriptionSnippet,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+	}
+
+	productObj.Price = money.New(tmpPrice, tmpCurrency)
+
+	if tmpCategoryId.Valid {
+		categoryId := strconv.FormatInt(tmpCategoryId.Int64, 10)
+		productObj.CategoryId = &categoryId
+	}
+
+	// convert postgres timestamps to unix format
+	if tmpCreatedAt.Valid {
+		productObj.CreatedAt = tmpCreatedAt.Time.Unix()
+	} else {
+		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to scan object from database (timestamp conversion)")
+	}
+
+	if tmpUpdatedAt.Valid {
+		unixUpdated := tmpUpdatedAt.Time.Unix()
+		productObj.UpdatedAt = &unixUpdated
+	}
+
+	result.Product = &productObj
+	return &result, nil
+}
+
 // Scan a postgres row to a protobuf object
 func scanRowToProduct(row pgx.Row) (*pb.Product, error) {
 	var productObj pb.Product
 
 	// Temporary variables that require conversion
+	var tmpPrice int64
+	var tmpCurrency string
 	var tmpCreatedAt pgtype.Timestamp
 	var tmpUpdatedAt pgtype.Timestamp
+	var tmpCategoryId pgtype.Int8
 
 	err := row.Scan(
 		&productObj.Id,
 		&productObj.Name,
 		&productObj.Description,
-		&productObj.Price,
+		&tmpPrice,
+		&tmpCurrency,
 		&tmpCreatedAt,
 		&tmpUpdatedAt,
+		&tmpCategoryId,
+		&productObj.Tags,
 	)
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -279,6 +807,13 @@ func scanRowToProduct(row pgx.Row) (*pb.Product, error) {
 		}
 	}
 
+	productObj.Price = money.New(tmpPrice, tmpCurrency)
+
+	if tmpCategoryId.Valid {
+		categoryId := strconv.FormatInt(tmpCategoryId.Int64, 10)
+		productObj.CategoryId = &categoryId
+	}
+
 	// convert postgres timestamps to unix format
 	if tmpCreatedAt.Valid {
 		productObj.CreatedAt = tmpCreatedAt.Time.Unix()
@@ -0,0 +1,379 @@
+// Copyright (C) 2024 Declan Teevan
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
+	pb "github.com/hexolan/stocklet/internal/pkg/protogen/product/v1"
+	"github.com/hexolan/stocklet/internal/svc/product"
+)
+
+// Variants without a price override take the price of their product
+const pgVariantBaseQuery string = `SELECT v.id, v.product_id, v.sku, COALESCE(v.price, p.price), p.currency, v.price IS NOT NULL, v.attributes, v.created_at, v.updated_at
+	FROM product_variants v JOIN products p ON p.id = v.product_id`
+
+func (c postgresController) GetProductVariant(ctx context.Context, variantId string) (*pb.ProductVariant, error) {
+	return c.getProductVariant(ctx, nil, variantId)
+}
+
+func (c postgresController) getProductVariant(ctx context.Context, tx *pgx.Tx, variantId string) (*pb.ProductVariant, error) {
+	// Determine if a db transaction is being used
+	var row pgx.Row
+	const query = pgVariantBaseQuery + " WHERE v.id=$1"
+	if tx == nil {
+		row = c.cl.QueryRow(ctx, query, variantId)
+	} else {
+		row = (*tx).QueryRow(ctx, query, variantId)
+	}
+
+	return scanRowToProductVariant(row)
+}
+
+func (c postgresController) GetProductVariants(ctx context.Context, productId string) ([]*pb.ProductVariant, error) {
+	return c.getProductVariants(ctx, nil, productId)
+}
+
+func (c postgresController) getProductVariants(ctx context.Context, tx *pgx.Tx, productId string) ([]*pb.ProductVariant, error) {
+	// Determine if a db transaction is being used
+	var rows pgx.Rows
+	var err error
+	const query = pgVariantBaseQuery + " WHERE v.product_id=$1 ORDER BY v.id"
+	if tx == nil {
+		rows, err = c.cl.Query(ctx, query, productId)
+	} else {
+		rows, err = (*tx).Query(ctx, query, productId)
+	}
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "query error", err)
+	}
+	defer rows.Close()
+
+	variants := []*pb.ProductVariant{}
+	for rows.Next() {
+		variant, err := scanRowToProductVariant(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, variant)
+	}
+
+	if rows.Err() != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "error whilst scanning rows", rows.Err())
+	}
+
+	return variants, nil
+}
+
+func (c postgresController) CreateProductVariant(ctx context.Context, productId string, sku string, price *commonpb.Money, attributes map[string]string) (*pb.ProductVariant, error) {
+	// Ensure any price override is in the base currency
+	if price != nil {
+		if err := c.validatePrice(price); err != nil {
+			return nil, err
+		}
+	}
+
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Ensure the product exists
+	if _, err := c.getProduct(ctx, &tx, productId); err != nil {
+		return nil, err
+	}
+
+	// Create the variant
+	variant, err := c.createProductVariant(ctx, tx, productId, sku, price, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return variant, nil
+}
+
+// Insert a variant (and the corresponding event) as part of a transaction.
+func (c postgresController) createProductVariant(ctx context.Context, tx pgx.Tx, productId string, sku string, price *commonpb.Money, attributes map[string]string) (*pb.ProductVariant, error) {
+	// Ensure the SKU is not taken by another variant
+	if err := c.ensureSkuAvailable(ctx, tx, sku, nil); err != nil {
+		return nil, err
+	}
+
+	var priceOverride *int64
+	if price != nil {
+		priceOverride = &price.MinorUnits
+	}
+
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	// Insert the variant
+	var variantId string
+	err := tx.QueryRow(
+		ctx,
+		"INSERT INTO product_variants (product_id, sku, price, attributes) VALUES ($1, $2, $3, $4) RETURNING id",
+		productId,
+		sku,
+		priceOverride,
+		attributes,
+	).Scan(&variantId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create product variant", err)
+	}
+
+	variant, err := c.getProductVariant(ctx, &tx, variantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := product.PrepareProductVariantCreatedEvent(variant)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", variant.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return variant, nil
+}
+
+func (c postgresController) UpdateProductVariant(ctx context.Context, variantId string, update *product.VariantUpdate) (*pb.ProductVariant, error) {
+	// Ensure any price override is in the base currency
+	if update.Price != nil {
+		if err := c.validatePrice(update.Price); err != nil {
+			return nil, err
+		}
+	}
+
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	variant, err := c.getProductVariant(ctx, &tx, variantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Apply the changes
+	sku := variant.Sku
+	if update.Sku != nil && *update.Sku != sku {
+		sku = *update.Sku
+
+		// Ensure the SKU is not taken by another variant
+		if err := c.ensureSkuAvailable(ctx, tx, sku, &variantId); err != nil {
+			return nil, err
+		}
+	}
+
+	var priceOverride *int64
+	if variant.PriceOverridden {
+		priceOverride = &variant.Price.MinorUnits
+	}
+
+	if update.SetPrice {
+		priceOverride = nil
+		if update.Price != nil {
+			priceOverride = &update.Price.MinorUnits
+		}
+	}
+
+	attributes := variant.Attributes
+	if update.SetAttributes {
+		attributes = update.Attributes
+	}
+
+	if attributes == nil {
+		attributes = map[string]string{}
+	}
+
+	// Update the variant
+	_, err = tx.Exec(
+		ctx,
+		"UPDATE product_variants SET sku=$1, price=$2, attributes=$3, updated_at=timezone('utc', now()) WHERE id=$4",
+		sku,
+		priceOverride,
+		attributes,
+		variantId,
+	)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product variant", err)
+	}
+
+	variant, err = c.getProductVariant(ctx, &tx, variantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	if err := c.addVariantUpdatedEvent(ctx, tx, variant); err != nil {
+		return nil, err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return variant, nil
+}
+
+func (c postgresController) DeleteProductVariant(ctx context.Context, variantId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	variant, err := c.getProductVariant(ctx, &tx, variantId)
+	if err != nil {
+		return err
+	}
+
+	// Ensure the product is left with at least one variant
+	var variantCount int64
+	err = tx.QueryRow(ctx, "SELECT COUNT(*) FROM product_variants WHERE product_id=$1", variant.ProductId).Scan(&variantCount)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to count product variants", err)
+	} else if variantCount <= 1 {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "the last variant of a product cannot be deleted")
+	}
+
+	// Delete the variant
+	_, err = tx.Exec(ctx, "DELETE FROM product_variants WHERE id=$1", variantId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to delete product variant", err)
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := product.PrepareProductVariantDeletedEvent(variant)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", variant.Id, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+func (c postgresController) addVariantUpdatedEvent(ctx context.Context, tx pgx.Tx, variant *pb.ProductVariant) error {
+	evt, evtTopic, err := product.PrepareProductVariantUpdatedEvent(variant)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", variant.Id, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	return nil
+}
+
+// Ensure that no other variant has the SKU.
+func (c postgresController) ensureSkuAvailable(ctx context.Context, tx pgx.Tx, sku string, excludeId *string) error {
+	var taken bool
+	err := tx.QueryRow(
+		ctx,
+		"SELECT EXISTS (SELECT 1 FROM product_variants WHERE sku=$1 AND id IS DISTINCT FROM $2::bigint)",
+		sku,
+		excludeId,
+	).Scan(&taken)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to check sku", err)
+	} else if taken {
+		return errors.NewServiceError(errors.ErrCodeAlreadyExists, "a product variant with that sku already exists")
+	}
+
+	return nil
+}
+
+func scanRowToProductVariant(row pgx.Row) (*pb.ProductVariant, error) {
+	var variant pb.ProductVariant
+
+	// Temporary variables that require conversion
+	var tmpPrice int64
+	var tmpCurrency string
+	var tmpCreatedAt pgtype.Timestamp
+	var tmpUpdatedAt pgtype.Timestamp
+
+	err := row.Scan(
+		&variant.Id,
+		&variant.ProductId,
+		&variant.Sku,
+		&tmpPrice,
+		&tmpCurrency,
+		&variant.PriceOverridden,
+		&variant.Attributes,
+		&tmpCreatedAt,
+		&tmpUpdatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, errors.WrapServiceError(errors.ErrCodeNotFound, "product variant not found", err)
+		} else {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan object from database", err)
+		}
+	}
+
+	variant.Price = money.New(tmpPrice, tmpCurrency)
+
+	// convert postgres timestamps to unix format
+	if tmpCreatedAt.Valid {
+		variant.CreatedAt = tmpCreatedAt.Time.Unix()
+	} else {
+		return nil, errors.NewServiceError(errors.ErrCodeUnknown, "failed to scan object from database (timestamp conversion)")
+	}
+
+	if tmpUpdatedAt.Valid {
+		unixUpdated := tmpUpdatedAt.Time.Unix()
+		variant.UpdatedAt = &unixUpdated
+	}
+
+	return &variant, nil
+}
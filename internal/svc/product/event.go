@@ -17,6 +17,7 @@ package product
 
 import (
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventspb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/product/v1"
 )
@@ -24,12 +25,15 @@ import (
 func PrepareProductCreatedEvent(product *pb.Product) ([]byte, string, error) {
 	topic := messaging.Product_State_Created_Topic
 	event := &eventspb.ProductCreatedEvent{
-		Revision: 1,
+		Revision: 3,
 
 		ProductId:   product.Id,
 		Name:        product.Name,
 		Description: product.Description,
 		Price:       product.Price,
+
+		CategoryId: product.CategoryId,
+		Tags:       product.Tags,
 	}
 
 	return messaging.MarshalEvent(event, topic)
@@ -38,7 +42,7 @@ func PrepareProductCreatedEvent(product *pb.Product) ([]byte, string, error) {
 func PrepareProductPriceUpdatedEvent(product *pb.Product) ([]byte, string, error) {
 	topic := messaging.Product_Attribute_Price_Topic
 	event := &eventspb.ProductPriceUpdatedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		ProductId: product.Id,
 		Price:     product.Price,
@@ -47,6 +51,33 @@ func PrepareProductPriceUpdatedEvent(product *pb.Product) ([]byte, string, error
 	return messaging.MarshalEvent(event, topic)
 }
 
+func PrepareProductDetailsUpdatedEvent(product *pb.Product) ([]byte, string, error) {
+	topic := messaging.Product_Attribute_Details_Topic
+	event := &eventspb.ProductDetailsUpdatedEvent{
+		Revision: 2,
+
+		ProductId:   product.Id,
+		Name:        product.Name,
+		Description: product.Description,
+
+		Tags: product.Tags,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareProductCategoryUpdatedEvent(product *pb.Product) ([]byte, string, error) {
+	topic := messaging.Product_Attribute_Category_Topic
+	event := &eventspb.ProductCategoryUpdatedEvent{
+		Revision: 1,
+
+		ProductId:  product.Id,
+		CategoryId: product.CategoryId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
 func PrepareProductDeletedEvent(product *pb.Product) ([]byte, string, error) {
 	topic := messaging.Product_State_Deleted_Topic
 	event := &eventspb.ProductDeletedEvent{
@@ -58,16 +89,98 @@ func PrepareProductDeletedEvent(product *pb.Product) ([]byte, string, error) {
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareProductPriceQuoteEvent_Available(orderId string, productQuantities map[string]int32, productPrices map[string]float32, totalPrice float32) ([]byte, string, error) {
+func PrepareProductVariantCreatedEvent(variant *pb.ProductVariant) ([]byte, string, error) {
+	topic := messaging.Product_Variant_Created_Topic
+	event := &eventspb.ProductVariantCreatedEvent{
+		Revision: 1,
+
+		VariantId:  variant.Id,
+		ProductId:  variant.ProductId,
+		Sku:        variant.Sku,
+		Price:      variant.Price,
+		Attributes: variant.Attributes,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareProductVariantUpdatedEvent(variant *pb.ProductVariant) ([]byte, string, error) {
+	topic := messaging.Product_Variant_Updated_Topic
+	event := &eventspb.ProductVariantUpdatedEvent{
+		Revision: 1,
+
+		VariantId:  variant.Id,
+		ProductId:  variant.ProductId,
+		Sku:        variant.Sku,
+		Price:      variant.Price,
+		Attributes: variant.Attributes,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareProductVariantDeletedEvent(variant *pb.ProductVariant) ([]byte, string, error) {
+	topic := messaging.Product_Variant_Deleted_Topic
+	event := &eventspb.ProductVariantDeletedEvent{
+		Revision: 1,
+
+		VariantId: variant.Id,
+		ProductId: variant.ProductId,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareCategoryCreatedEvent(category *pb.Category) ([]byte, string, error) {
+	topic := messaging.Product_Category_Created_Topic
+	event := &eventspb.CategoryCreatedEvent{
+		Revision: 1,
+
+		CategoryId: category.Id,
+		ParentId:   category.ParentId,
+		Name:       category.Name,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareCategoryUpdatedEvent(category *pb.Category) ([]byte, string, error) {
+	topic := messaging.Product_Category_Updated_Topic
+	event := &eventspb.CategoryUpdatedEvent{
+		Revision: 1,
+
+		CategoryId: category.Id,
+		ParentId:   category.ParentId,
+		Name:       category.Name,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareCategoryDeletedEvent(category *pb.Category) ([]byte, string, error) {
+	topic := messaging.Product_Category_Deleted_Topic
+	event := &eventspb.CategoryDeletedEvent{
+		Revision: 1,
+
+		CategoryId: category.Id,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareProductPriceQuoteEvent_Available(orderId string, variantQuantities map[string]int32, variantPrices map[string]*commonpb.Money, variantProducts map[string]string, totalPrice *commonpb.Money, baseCurrency string, exchangeRate float64) ([]byte, string, error) {
 	topic := messaging.Product_PriceQuotation_Topic
 	event := &eventspb.ProductPriceQuoteEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:              eventspb.ProductPriceQuoteEvent_TYPE_AVAILABLE,
 		OrderId:           orderId,
-		ProductQuantities: productQuantities,
-		ProductPrices:     productPrices,
+		VariantQuantities: variantQuantities,
+		VariantPrices:     variantPrices,
+		VariantProducts:   variantProducts,
 		TotalPrice:        totalPrice,
+		BaseCurrency:      baseCurrency,
+		ExchangeRate:      exchangeRate,
 	}
 
 	return messaging.MarshalEvent(event, topic)
@@ -76,7 +189,7 @@ func PrepareProductPriceQuoteEvent_Available(orderId string, productQuantities m
 func PrepareProductPriceQuoteEvent_Unavailable(orderId string) ([]byte, string, error) {
 	topic := messaging.Product_PriceQuotation_Topic
 	event := &eventspb.ProductPriceQuoteEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:    eventspb.ProductPriceQuoteEvent_TYPE_UNAVAILABLE,
 		OrderId: orderId,
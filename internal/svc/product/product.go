@@ -17,6 +17,8 @@ package product
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/bufbuild/protovalidate-go"
 	"github.com/rs/zerolog/log"
@@ -41,12 +43,80 @@ type ProductService struct {
 // Flexibility for implementing separate controllers for different databases (e.g. Postgres, MongoDB, etc)
 type StorageController interface {
 	GetProduct(ctx context.Context, productId string) (*pb.Product, error)
-	GetProducts(ctx context.Context) ([]*pb.Product, error)
+	GetProducts(ctx context.Context, filter *ProductFilter, pageSize int32, pageToken string) ([]*pb.Product, string, error)
+	SearchProducts(ctx context.Context, query string, pageSize int32, pageToken string) ([]*pb.ProductSearchResult, string, error)
 
-	UpdateProductPrice(ctx context.Context, productId string, price float32) (*pb.Product, error)
+	CreateProduct(ctx context.Context, name string, description string, price *commonpb.Money, categoryId *string, tags []string, sku *string) (*pb.Product, error)
+	UpdateProduct(ctx context.Context, productId string, update *ProductUpdate) (*pb.Product, error)
 	DeleteProduct(ctx context.Context, productId string) error
 
-	PriceOrderProducts(ctx context.Context, orderId string, customerId string, productQuantities map[string]int32) error
+	GetProductVariant(ctx context.Context, variantId string) (*pb.ProductVariant, error)
+	GetProductVariants(ctx context.Context, productId string) ([]*pb.ProductVariant, error)
+
+	CreateProductVariant(ctx context.Context, productId string, sku string, price *commonpb.Money, attributes map[string]string) (*pb.ProductVariant, error)
+	UpdateProductVariant(ctx context.Context, variantId string, update *VariantUpdate) (*pb.ProductVariant, error)
+	DeleteProductVariant(ctx context.Context, variantId string) error
+
+	GetCategory(ctx context.Context, categoryId string) (*pb.Category, error)
+	GetCategorySummaries(ctx context.Context, parentId *string) ([]*pb.CategorySummary, error)
+
+	CreateCategory(ctx context.Context, name string, parentId *string) (*pb.Category, error)
+	UpdateCategory(ctx context.Context, categoryId string, update *CategoryUpdate) (*pb.Category, error)
+	DeleteCategory(ctx context.Context, categoryId string) error
+
+	PriceOrderProducts(ctx context.Context, orderId string, customerId string, variantQuantities map[string]int32, currency string) error
+}
+
+// Criteria for listing the product catalogue
+type ProductFilter struct {
+	// Prices in minor units of the base currency
+	MinPrice *int64
+	MaxPrice *int64
+
+	// Products listed under the category (or any of its subcategories)
+	CategoryId *string
+
+	// Products with the tag
+	Tag *string
+
+	// Ordering of the results
+	SortBy     pb.ProductSortField
+	Descending bool
+}
+
+// Changes to the fields of a product (unset fields are left unchanged)
+type ProductUpdate struct {
+	Name        *string
+	Description *string
+	Price       *commonpb.Money
+
+	// A nil category with SetCategory removes the product from its category
+	CategoryId  *string
+	SetCategory bool
+
+	Tags    []string
+	SetTags bool
+}
+
+// Changes to a product variant (unset fields are left unchanged)
+type VariantUpdate struct {
+	Sku *string
+
+	// A nil price with SetPrice removes the price override
+	Price    *commonpb.Money
+	SetPrice bool
+
+	Attributes    map[string]string
+	SetAttributes bool
+}
+
+// Changes to a category (unset fields are left unchanged)
+type CategoryUpdate struct {
+	Name *string
+
+	// A nil parent with SetParent moves the category to the top-level
+	ParentId  *string
+	SetParent bool
 }
 
 // Interface for event consumption
@@ -93,21 +163,509 @@ func (svc ProductService) ViewProduct(ctx context.Context, req *pb.ViewProductRe
 		return nil, err
 	}
 
-	return &pb.ViewProductResponse{Product: product}, nil
+	// Get the variants of the product
+	variants, err := svc.store.GetProductVariants(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewProductResponse{Product: product, Variants: variants}, nil
 }
 
 func (svc ProductService) ViewProducts(ctx context.Context, req *pb.ViewProductsRequest) (*pb.ViewProductsResponse, error) {
-	// todo: pagination mechanism
-	products, err := svc.store.GetProducts(ctx)
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.MinPrice != nil && req.MaxPrice != nil && *req.MinPrice > *req.MaxPrice {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: min_price must not exceed max_price")
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 20
+	}
+
+	// Get the products from the DB
+	products, nextPageToken, err := svc.store.GetProducts(
+		ctx,
+		&ProductFilter{
+			MinPrice:   req.MinPrice,
+			MaxPrice:   req.MaxPrice,
+			CategoryId: req.CategoryId,
+			Tag:        normaliseTag(req.Tag),
+			SortBy:     req.SortBy,
+			Descending: req.Descending,
+		},
+		pageSize,
+		req.PageToken,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewProductsResponse{Products: products, NextPageToken: nextPageToken}, nil
+}
+
+func (svc ProductService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = 20
+	}
+
+	// Search the products in the DB
+	results, nextPageToken, err := svc.store.SearchProducts(ctx, req.Query, pageSize, req.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SearchProductsResponse{Results: results, NextPageToken: nextPageToken}, nil
+}
+
+func (svc ProductService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.CreateProductResponse, error) {
+	// Ensure the caller is permitted to create products
+	if err := authorizeAdmin(ctx, "create products"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.Price.MinorUnits <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: price must be positive")
+	}
+
+	// Create the product (with a default variant)
+	// Dispatch ProductCreatedEvent and ProductVariantCreatedEvent
+	product, err := svc.store.CreateProduct(ctx, req.Name, req.Description, req.Price, req.CategoryId, normaliseTags(req.Tags), req.Sku)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateProductResponse{Product: product}, nil
+}
+
+func (svc ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
+	// Ensure the caller is permitted to update products
+	if err := authorizeAdmin(ctx, "update products"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Determine the fields to update
+	update, err := productUpdateFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the product
+	// Dispatch ProductDetailsUpdatedEvent, ProductPriceUpdatedEvent and/or ProductCategoryUpdatedEvent
+	product, err := svc.store.UpdateProduct(ctx, req.Id, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateProductResponse{Product: product}, nil
+}
+
+func (svc ProductService) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
+	// Ensure the caller is permitted to delete products
+	if err := authorizeAdmin(ctx, "delete products"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Delete the product
+	// Dispatch ProductDeletedEvent
+	err := svc.store.DeleteProduct(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProductResponse{}, nil
+}
+
+func (svc ProductService) ViewProductVariant(ctx context.Context, req *pb.ViewProductVariantRequest) (*pb.ViewProductVariantResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Get the variant from the DB
+	variant, err := svc.store.GetProductVariant(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the product the variant belongs to
+	product, err := svc.store.GetProduct(ctx, variant.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewProductVariantResponse{Variant: variant, Product: product}, nil
+}
+
+func (svc ProductService) CreateProductVariant(ctx context.Context, req *pb.CreateProductVariantRequest) (*pb.CreateProductVariantResponse, error) {
+	// Ensure the caller is permitted to create product variants
+	if err := authorizeAdmin(ctx, "create product variants"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	} else if req.Price != nil && req.Price.MinorUnits <= 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: price must be positive")
+	}
+
+	// Create the variant
+	// Dispatch ProductVariantCreatedEvent
+	variant, err := svc.store.CreateProductVariant(ctx, req.ProductId, req.Sku, req.Price, req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateProductVariantResponse{Variant: variant}, nil
+}
+
+func (svc ProductService) UpdateProductVariant(ctx context.Context, req *pb.UpdateProductVariantRequest) (*pb.UpdateProductVariantResponse, error) {
+	// Ensure the caller is permitted to update product variants
+	if err := authorizeAdmin(ctx, "update product variants"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Determine the fields to update
+	update, err := variantUpdateFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the variant
+	// Dispatch ProductVariantUpdatedEvent
+	variant, err := svc.store.UpdateProductVariant(ctx, req.Id, update)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.ViewProductsResponse{Products: products}, nil
+	return &pb.UpdateProductVariantResponse{Variant: variant}, nil
+}
+
+func (svc ProductService) DeleteProductVariant(ctx context.Context, req *pb.DeleteProductVariantRequest) (*pb.DeleteProductVariantResponse, error) {
+	// Ensure the caller is permitted to delete product variants
+	if err := authorizeAdmin(ctx, "delete product variants"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Delete the variant
+	// Dispatch ProductVariantDeletedEvent
+	err := svc.store.DeleteProductVariant(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteProductVariantResponse{}, nil
+}
+
+func (svc ProductService) ViewCategories(ctx context.Context, req *pb.ViewCategoriesRequest) (*pb.ViewCategoriesResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Get the category being browsed
+	var parent *pb.Category
+	if req.ParentId != nil {
+		var err error
+		parent, err = svc.store.GetCategory(ctx, *req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Get the subcategories from the DB
+	categories, err := svc.store.GetCategorySummaries(ctx, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ViewCategoriesResponse{Parent: parent, Categories: categories}, nil
+}
+
+func (svc ProductService) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
+	// Ensure the caller is permitted to create categories
+	if err := authorizeAdmin(ctx, "create categories"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Create the category
+	// Dispatch CategoryCreatedEvent
+	category, err := svc.store.CreateCategory(ctx, req.Name, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCategoryResponse{Category: category}, nil
+}
+
+func (svc ProductService) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryRequest) (*pb.UpdateCategoryResponse, error) {
+	// Ensure the caller is permitted to update categories
+	if err := authorizeAdmin(ctx, "update categories"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Determine the fields to update
+	update, err := categoryUpdateFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the category
+	// Dispatch CategoryUpdatedEvent
+	category, err := svc.store.UpdateCategory(ctx, req.Id, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateCategoryResponse{Category: category}, nil
+}
+
+func (svc ProductService) DeleteCategory(ctx context.Context, req *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	// Ensure the caller is permitted to delete categories
+	if err := authorizeAdmin(ctx, "delete categories"); err != nil {
+		return nil, err
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Delete the category
+	// Dispatch CategoryDeletedEvent
+	err := svc.store.DeleteCategory(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCategoryResponse{}, nil
+}
+
+// Build the product changes from the fields specified in the update mask.
+//
+// If no update mask is provided, then the fields set in the request are updated.
+func productUpdateFromRequest(req *pb.UpdateProductRequest) (*ProductUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.Name != nil {
+			paths = append(paths, "name")
+		}
+		if req.Description != nil {
+			paths = append(paths, "description")
+		}
+		if req.Price != nil {
+			paths = append(paths, "price")
+		}
+		if req.CategoryId != nil {
+			paths = append(paths, "category_id")
+		}
+		if len(req.Tags) > 0 {
+			paths = append(paths, "tags")
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: no fields to update")
+	}
+
+	update := &ProductUpdate{}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if req.Name == nil {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: name must be provided")
+			}
+			update.Name = req.Name
+		case "description":
+			if req.Description == nil {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: description must be provided")
+			}
+			update.Description = req.Description
+		case "price":
+			if req.Price == nil || req.Price.MinorUnits <= 0 {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: price must be positive")
+			}
+			update.Price = req.Price
+		case "category_id":
+			update.CategoryId = req.CategoryId
+			update.SetCategory = true
+		case "tags":
+			update.Tags = normaliseTags(req.Tags)
+			update.SetTags = true
+		default:
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "invalid request: unsupported update mask path (%s)", path)
+		}
+	}
+
+	return update, nil
+}
+
+// Build the variant changes from the fields specified in the update mask.
+//
+// If no update mask is provided, then the fields set in the request are updated.
+func variantUpdateFromRequest(req *pb.UpdateProductVariantRequest) (*VariantUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.Sku != nil {
+			paths = append(paths, "sku")
+		}
+		if req.Price != nil {
+			paths = append(paths, "price")
+		}
+		if len(req.Attributes) > 0 {
+			paths = append(paths, "attributes")
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: no fields to update")
+	}
+
+	update := &VariantUpdate{}
+	for _, path := range paths {
+		switch path {
+		case "sku":
+			if req.Sku == nil {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: sku must be provided")
+			}
+			update.Sku = req.Sku
+		case "price":
+			if req.Price != nil && req.Price.MinorUnits <= 0 {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: price must be positive")
+			}
+			update.Price = req.Price
+			update.SetPrice = true
+		case "attributes":
+			update.Attributes = req.Attributes
+			update.SetAttributes = true
+		default:
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "invalid request: unsupported update mask path (%s)", path)
+		}
+	}
+
+	return update, nil
+}
+
+// Build the category changes from the fields specified in the update mask.
+//
+// If no update mask is provided, then the fields set in the request are updated.
+func categoryUpdateFromRequest(req *pb.UpdateCategoryRequest) (*CategoryUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		if req.Name != nil {
+			paths = append(paths, "name")
+		}
+		if req.ParentId != nil {
+			paths = append(paths, "parent_id")
+		}
+	}
+
+	if len(paths) == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: no fields to update")
+	}
+
+	update := &CategoryUpdate{}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			if req.Name == nil {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: name must be provided")
+			}
+			update.Name = req.Name
+		case "parent_id":
+			if req.ParentId != nil && *req.ParentId == req.Id {
+				return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: a category cannot be its own parent")
+			}
+			update.ParentId = req.ParentId
+			update.SetParent = true
+		default:
+			return nil, errors.NewServiceErrorf(errors.ErrCodeInvalidArgument, "invalid request: unsupported update mask path (%s)", path)
+		}
+	}
+
+	return update, nil
+}
+
+// Normalise product tags to lowercase, removing any duplicates.
+func normaliseTags(tags []string) []string {
+	normalised := []string{}
+	for _, tag := range tags {
+		tag = *normaliseTag(&tag)
+		if tag != "" && !slices.Contains(normalised, tag) {
+			normalised = append(normalised, tag)
+		}
+	}
+
+	return normalised
+}
+
+func normaliseTag(tag *string) *string {
+	if tag == nil {
+		return nil
+	}
+
+	normalised := strings.ToLower(strings.TrimSpace(*tag))
+	return &normalised
 }
 
 func (svc ProductService) ProcessOrderCreatedEvent(ctx context.Context, req *eventpb.OrderCreatedEvent) (*emptypb.Empty, error) {
-	err := svc.store.PriceOrderProducts(ctx, req.OrderId, req.CustomerId, req.ItemQuantities)
+	// The order items are keyed by variant id
+	err := svc.store.PriceOrderProducts(ctx, req.OrderId, req.CustomerId, req.ItemQuantities, req.CurrencyCode)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
 	}
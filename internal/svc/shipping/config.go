@@ -22,7 +22,8 @@ import (
 // Order Service Configuration
 type ServiceConfig struct {
 	// Core Configuration
-	Shared config.SharedConfig
+	Shared      config.SharedConfig
+	ServiceOpts ServiceConfigOpts
 
 	// Dynamically loaded configuration
 	Postgres config.PostgresConfig
@@ -38,5 +39,27 @@ func NewServiceConfig() (*ServiceConfig, error) {
 		return nil, err
 	}
 
+	// load the service config opts
+	if err := cfg.ServiceOpts.Load(); err != nil {
+		return nil, err
+	}
+
 	return &cfg, nil
 }
+
+// Service specific config options
+type ServiceConfigOpts struct {
+	// Env Var: "PAYMENT_SERVICE_GRPC" (optional)
+	// Used to check the payment status of shipments allocated before payment
+	// authorizations were tracked. Those shipments cannot be dispatched when not set.
+	PaymentServiceGrpc *string
+}
+
+// Load the ServiceConfigOpts
+func (opts *ServiceConfigOpts) Load() error {
+	if paymentServiceGrpc, err := config.RequireFromEnv("PAYMENT_SERVICE_GRPC"); err == nil {
+		opts.PaymentServiceGrpc = &paymentServiceGrpc
+	}
+
+	return nil
+}
@@ -51,6 +51,7 @@ func NewKafkaController(cl *kgo.Client) shipping.ConsumerController {
 		messaging.Warehouse_Reservation_Reserved_Topic,
 
 		messaging.Payment_Processing_Topic,
+		messaging.Payment_Transaction_Voided_Topic,
 	)
 	if err != nil {
 		log.Warn().Err(err).Msg("kafka: raised attempting to ensure svc topics")
@@ -60,6 +61,7 @@ func NewKafkaController(cl *kgo.Client) shipping.ConsumerController {
 	cl.AddConsumeTopics(
 		messaging.Warehouse_Reservation_Reserved_Topic,
 		messaging.Payment_Processing_Topic,
+		messaging.Payment_Transaction_Voided_Topic,
 	)
 
 	return &kafkaController{cl: cl, ctx: ctx, ctxCancel: ctxCancel}
@@ -86,6 +88,8 @@ func (c *kafkaController) Start() {
 				c.consumeStockReservationEventTopic(ft)
 			case messaging.Payment_Processing_Topic:
 				c.consumePaymentProcessedEventTopic(ft)
+			case messaging.Payment_Transaction_Voided_Topic:
+				c.consumeTransactionVoidedEventTopic(ft)
 			default:
 				log.Warn().Str("topic", ft.Topic).Msg("consumer: received records from unexpected topic")
 			}
@@ -133,3 +137,21 @@ func (c *kafkaController) consumePaymentProcessedEventTopic(ft kgo.FetchTopic) {
 		c.svc.ProcessPaymentProcessedEvent(ctx, &event)
 	})
 }
+
+func (c *kafkaController) consumeTransactionVoidedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.TransactionVoidedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessTransactionVoidedEvent(ctx, &event)
+	})
+}
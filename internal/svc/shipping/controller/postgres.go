@@ -17,29 +17,36 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/doug-martin/goqu/v9"
 	_ "github.com/doug-martin/goqu/v9/dialect/postgres"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	paymentpb "github.com/hexolan/stocklet/internal/pkg/protogen/payment/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/shipping/v1"
 	"github.com/hexolan/stocklet/internal/svc/shipping"
 )
 
 const (
 	pgShipmentBaseQuery      string = "SELECT id, order_id, dispatched, created_at FROM shipments"
-	pgShipmentItemsBaseQuery string = "SELECT shipment_id, product_id, quantity FROM shipment_items"
+	pgShipmentItemsBaseQuery string = "SELECT shipment_id, variant_id, quantity FROM shipment_items"
 )
 
 type postgresController struct {
 	cl *pgxpool.Pool
+
+	serviceOpts *shipping.ServiceConfigOpts
 }
 
-func NewPostgresController(cl *pgxpool.Pool) shipping.StorageController {
-	return postgresController{cl: cl}
+func NewPostgresController(cl *pgxpool.Pool, serviceOpts *shipping.ServiceConfigOpts) shipping.StorageController {
+	return postgresController{cl: cl, serviceOpts: serviceOpts}
 }
 
 func (c postgresController) GetShipment(ctx context.Context, shipmentId string) (*pb.Shipment, error) {
@@ -107,7 +114,7 @@ func (c postgresController) getShipmentItems(ctx context.Context, tx *pgx.Tx, sh
 		var shipmentItem pb.ShipmentItem
 		shipmentItem.ShipmentId = shipmentId
 		err := rows.Scan(
-			&shipmentItem.ProductId,
+			&shipmentItem.VariantId,
 			&shipmentItem.Quantity,
 		)
 		if err != nil {
@@ -124,7 +131,7 @@ func (c postgresController) getShipmentItems(ctx context.Context, tx *pgx.Tx, sh
 	return shipmentItems, nil
 }
 
-func (c postgresController) AllocateOrderShipment(ctx context.Context, orderId string, orderMetadata shipping.EventOrderMetadata, productQuantities map[string]int32) error {
+func (c postgresController) AllocateOrderShipment(ctx context.Context, orderId string, orderMetadata shipping.EventOrderMetadata, variantQuantities map[string]int32, backorderReservationId *string) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -133,22 +140,34 @@ func (c postgresController) AllocateOrderShipment(ctx context.Context, orderId s
 	defer tx.Rollback(ctx)
 
 	// Create shipment
+	//
+	// Filled backorders are paid for with the rest of the order, so can be
+	// dispatched if the payment for the order is held (or has been captured).
+	// The payment status is left unknown (NULL) if it is yet to be resolved
+	// for the other shipments of the order.
 	var shipmentId string
-	err = tx.QueryRow(ctx, "INSERT INTO shipments (order_id) VALUES ($1) RETURNING id", orderId).Scan(&shipmentId)
+	err = tx.QueryRow(
+		ctx,
+		`INSERT INTO shipments (order_id, payment_authorized) VALUES ($1, CASE WHEN $2 THEN (
+			SELECT CASE WHEN bool_or(payment_authorized) THEN TRUE WHEN bool_or(payment_authorized IS NULL) THEN NULL ELSE FALSE END FROM shipments WHERE order_id=$1
+		) ELSE FALSE END) RETURNING id`,
+		orderId,
+		backorderReservationId != nil,
+	).Scan(&shipmentId)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to create shipment", err)
 	}
 
 	// Add shipment items
 	vals := [][]interface{}{}
-	for productId, quantity := range productQuantities {
+	for variantId, quantity := range variantQuantities {
 		vals = append(
 			vals,
-			goqu.Vals{shipmentId, productId, quantity},
+			goqu.Vals{shipmentId, variantId, quantity},
 		)
 	}
 
-	statement, args, err := goqu.Dialect("postgres").From("shipment_items").Insert().Cols("shipment_id", "product_id", "quantity").Vals(vals...).Prepared(true).ToSQL()
+	statement, args, err := goqu.Dialect("postgres").From("shipment_items").Insert().Cols("shipment_id", "variant_id", "quantity").Vals(vals...).Prepared(true).ToSQL()
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to build statement", err)
 	}
@@ -159,7 +178,7 @@ func (c postgresController) AllocateOrderShipment(ctx context.Context, orderId s
 	}
 
 	// Prepare and append shipment allocated event to transaction
-	evt, evtTopic, err := shipping.PrepareShipmentAllocationEvent_Allocated(orderId, orderMetadata, shipmentId, productQuantities)
+	evt, evtTopic, err := shipping.PrepareShipmentAllocationEvent_Allocated(orderId, orderMetadata, shipmentId, variantQuantities, backorderReservationId)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
@@ -190,6 +209,8 @@ func (c postgresController) CancelOrderShipment(ctx context.Context, orderId str
 	shipment, err := c.getShipmentByOrderId(ctx, &tx, orderId)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch shipment info", err)
+	} else if shipment.Dispatched {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "shipment has already been dispatched")
 	}
 
 	// Get the shipment items
@@ -198,10 +219,12 @@ func (c postgresController) CancelOrderShipment(ctx context.Context, orderId str
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch shipment manifest", err)
 	}
 
-	// Delete shipment
-	_, err = tx.Exec(ctx, "DELETE FROM shipments WHERE id=$1", shipment.Id)
+	// Delete shipment (unless it has since been dispatched)
+	result, err := tx.Exec(ctx, "DELETE FROM shipments WHERE id=$1 AND dispatched=FALSE", shipment.Id)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to delete shipment", err)
+	} else if result.RowsAffected() == 0 {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "shipment has already been dispatched")
 	}
 
 	// Add the event to the outbox table with the transaction
@@ -224,6 +247,138 @@ func (c postgresController) CancelOrderShipment(ctx context.Context, orderId str
 	return nil
 }
 
+// Mark whether the payment for an order is held (permitting its undispatched shipments to be dispatched).
+func (c postgresController) SetOrderPaymentAuthorized(ctx context.Context, orderId string, authorized bool) error {
+	_, err := c.cl.Exec(ctx, "UPDATE shipments SET payment_authorized=$1 WHERE order_id=$2 AND dispatched=FALSE", authorized, orderId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update shipments", err)
+	}
+
+	return nil
+}
+
+func (c postgresController) DispatchShipment(ctx context.Context, shipmentId string) (*pb.Shipment, error) {
+	// Resolve the payment status of the shipment (if unknown)
+	err := c.resolvePaymentAuthorized(ctx, shipmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Get the shipment
+	shipment, err := c.getShipment(ctx, &tx, shipmentId)
+	if err != nil {
+		return nil, err
+	} else if shipment.Dispatched {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "shipment has already been dispatched")
+	}
+
+	// Get the shipment items
+	shipmentItems, err := c.getShipmentItems(ctx, &tx, shipment.Id)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch shipment manifest", err)
+	}
+
+	// Ensure the payment for the order is held
+	var paymentAuthorized bool
+	err = tx.QueryRow(ctx, "SELECT COALESCE(payment_authorized, FALSE) FROM shipments WHERE id=$1 FOR UPDATE", shipment.Id).Scan(&paymentAuthorized)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch shipment payment status", err)
+	} else if !paymentAuthorized {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "shipment is awaiting payment authorization")
+	}
+
+	// Mark the shipment as dispatched
+	result, err := tx.Exec(ctx, "UPDATE shipments SET dispatched = TRUE WHERE id=$1 AND dispatched = FALSE", shipment.Id)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update shipment", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidState, "shipment has already been dispatched")
+	}
+	shipment.Dispatched = true
+
+	// Add the event to the outbox table with the transaction
+	variantQuantities := make(map[string]int32)
+	for _, item := range shipmentItems {
+		variantQuantities[item.VariantId] = item.Quantity
+	}
+
+	evt, evtTopic, err := shipping.PrepareShipmentDispatchedEvent(shipment.OrderId, shipment.Id, variantQuantities)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", shipment.Id, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return shipment, nil
+}
+
+// Resolve an unknown payment status of a shipment with the payment service.
+//
+// Shipments allocated before payment authorizations were tracked are left
+// with an unknown (NULL) status, until checked when they are dispatched.
+func (c postgresController) resolvePaymentAuthorized(ctx context.Context, shipmentId string) error {
+	var orderId string
+	var paymentAuthorized pgtype.Bool
+	err := c.cl.QueryRow(ctx, "SELECT order_id, payment_authorized FROM shipments WHERE id=$1", shipmentId).Scan(&orderId, &paymentAuthorized)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return errors.WrapServiceError(errors.ErrCodeNotFound, "shipment not found", err)
+		}
+
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch shipment payment status", err)
+	} else if paymentAuthorized.Valid {
+		return nil
+	}
+
+	if c.serviceOpts.PaymentServiceGrpc == nil {
+		return errors.NewServiceError(errors.ErrCodeInvalidState, "shipment is awaiting payment authorization")
+	}
+
+	// Establish connection with payment service
+	paymentConn, err := grpc.Dial(
+		*c.serviceOpts.PaymentServiceGrpc,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to establish connection to payment service", err)
+	}
+	defer paymentConn.Close()
+	paymentCl := paymentpb.NewPaymentServiceClient(paymentConn)
+
+	paymentCtx, paymentCtxCancel := context.WithTimeout(ctx, time.Second*10)
+	defer paymentCtxCancel()
+
+	resp, err := paymentCl.CheckOrderPayment(paymentCtx, &paymentpb.CheckOrderPaymentRequest{OrderId: orderId})
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to check order payment", err)
+	}
+
+	// Store the status (unless it has since been set by a payment event)
+	_, err = c.cl.Exec(ctx, "UPDATE shipments SET payment_authorized=$1 WHERE id=$2 AND payment_authorized IS NULL", resp.Authorized, shipmentId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update shipment", err)
+	}
+
+	return nil
+}
+
 // Scan a postgres row to a protobuf object
 func scanRowToShipment(row pgx.Row) (*pb.Shipment, error) {
 	var shipment pb.Shipment
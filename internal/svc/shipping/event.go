@@ -17,80 +17,90 @@ package shipping
 
 import (
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventspb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/shipping/v1"
 )
 
 type EventOrderMetadata struct {
 	CustomerId string
-	ItemsPrice float32
-	TotalPrice float32
+	ItemsPrice *commonpb.Money
+	TotalPrice *commonpb.Money
+
+	PaymentMethod    commonpb.PaymentMethod
+	PaymentReference *string
 }
 
-func PrepareShipmentAllocationEvent_Failed(orderId string, orderMetadata EventOrderMetadata, productQuantities map[string]int32) ([]byte, string, error) {
+func PrepareShipmentAllocationEvent_Failed(orderId string, orderMetadata EventOrderMetadata, variantQuantities map[string]int32, backorderReservationId *string) ([]byte, string, error) {
 	topic := messaging.Shipping_Shipment_Allocation_Topic
 	event := &eventspb.ShipmentAllocationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:    eventspb.ShipmentAllocationEvent_TYPE_FAILED,
 		OrderId: orderId,
 		OrderMetadata: &eventspb.ShipmentAllocationEvent_OrderMetadata{
-			CustomerId: orderMetadata.CustomerId,
-			ItemsPrice: orderMetadata.ItemsPrice,
-			TotalPrice: orderMetadata.TotalPrice,
+			CustomerId:       orderMetadata.CustomerId,
+			ItemsPrice:       orderMetadata.ItemsPrice,
+			TotalPrice:       orderMetadata.TotalPrice,
+			PaymentMethod:    orderMetadata.PaymentMethod,
+			PaymentReference: orderMetadata.PaymentReference,
 		},
-		ProductQuantities: productQuantities,
+		VariantQuantities:      variantQuantities,
+		BackorderReservationId: backorderReservationId,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareShipmentAllocationEvent_Allocated(orderId string, orderMetadata EventOrderMetadata, shipmentId string, productQuantities map[string]int32) ([]byte, string, error) {
+func PrepareShipmentAllocationEvent_Allocated(orderId string, orderMetadata EventOrderMetadata, shipmentId string, variantQuantities map[string]int32, backorderReservationId *string) ([]byte, string, error) {
 	topic := messaging.Shipping_Shipment_Allocation_Topic
 	event := &eventspb.ShipmentAllocationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:    eventspb.ShipmentAllocationEvent_TYPE_ALLOCATED,
 		OrderId: orderId,
 		OrderMetadata: &eventspb.ShipmentAllocationEvent_OrderMetadata{
-			CustomerId: orderMetadata.CustomerId,
-			ItemsPrice: orderMetadata.ItemsPrice,
-			TotalPrice: orderMetadata.TotalPrice,
+			CustomerId:       orderMetadata.CustomerId,
+			ItemsPrice:       orderMetadata.ItemsPrice,
+			TotalPrice:       orderMetadata.TotalPrice,
+			PaymentMethod:    orderMetadata.PaymentMethod,
+			PaymentReference: orderMetadata.PaymentReference,
 		},
-		ShipmentId:        shipmentId,
-		ProductQuantities: productQuantities,
+		ShipmentId:             shipmentId,
+		VariantQuantities:      variantQuantities,
+		BackorderReservationId: backorderReservationId,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
 func PrepareShipmentAllocationEvent_AllocationReleased(orderId string, shipmentId string, shipmentItems []*pb.ShipmentItem) ([]byte, string, error) {
-	productQuantities := make(map[string]int32)
+	variantQuantities := make(map[string]int32)
 	for _, item := range shipmentItems {
-		productQuantities[item.ProductId] = item.Quantity
+		variantQuantities[item.VariantId] = item.Quantity
 	}
 
 	topic := messaging.Shipping_Shipment_Allocation_Topic
 	event := &eventspb.ShipmentAllocationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:              eventspb.ShipmentAllocationEvent_TYPE_ALLOCATION_RELEASED,
 		OrderId:           orderId,
 		ShipmentId:        shipmentId,
-		ProductQuantities: productQuantities,
+		VariantQuantities: variantQuantities,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareShipmentDispatchedEvent(orderId string, shipmentId string, productQuantities map[string]int32) ([]byte, string, error) {
+func PrepareShipmentDispatchedEvent(orderId string, shipmentId string, variantQuantities map[string]int32) ([]byte, string, error) {
 	topic := messaging.Shipping_Shipment_Dispatched_Topic
 	event := &eventspb.ShipmentDispatchedEvent{
-		Revision: 1,
+		Revision: 2,
 
 		OrderId:           orderId,
 		ShipmentId:        shipmentId,
-		ProductQuantities: productQuantities,
+		VariantQuantities: variantQuantities,
 	}
 
 	return messaging.MarshalEvent(event, topic)
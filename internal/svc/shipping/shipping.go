@@ -23,6 +23,7 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
 	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
@@ -43,8 +44,10 @@ type StorageController interface {
 	GetShipment(ctx context.Context, shipmentId string) (*pb.Shipment, error)
 	GetShipmentItems(ctx context.Context, shipmentId string) ([]*pb.ShipmentItem, error)
 
-	AllocateOrderShipment(ctx context.Context, orderId string, orderMetadata EventOrderMetadata, productQuantities map[string]int32) error
+	AllocateOrderShipment(ctx context.Context, orderId string, orderMetadata EventOrderMetadata, variantQuantities map[string]int32, backorderReservationId *string) error
 	CancelOrderShipment(ctx context.Context, orderId string) error
+	SetOrderPaymentAuthorized(ctx context.Context, orderId string, authorized bool) error
+	DispatchShipment(ctx context.Context, shipmentId string) (*pb.Shipment, error)
 }
 
 // Interface for event consumption
@@ -113,17 +116,48 @@ func (svc ShippingService) ViewShipmentManifest(ctx context.Context, req *pb.Vie
 	return &pb.ViewShipmentManifestResponse{Manifest: shipmentItems}, nil
 }
 
+func (svc ShippingService) DispatchShipment(ctx context.Context, req *pb.DispatchShipmentRequest) (*pb.DispatchShipmentResponse, error) {
+	// Shipments can only be dispatched internally (by admins)
+	if gatewayRequest, _ := gwauth.IsGatewayRequest(ctx); gatewayRequest {
+		return nil, errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to dispatch shipments")
+	}
+
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Mark the shipment as dispatched
+	// Dispatch ShipmentDispatchedEvent
+	shipment, err := svc.store.DispatchShipment(ctx, req.ShipmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DispatchShipmentResponse{Shipment: shipment}, nil
+}
+
 func (svc ShippingService) ProcessStockReservationEvent(ctx context.Context, req *eventpb.StockReservationEvent) (*emptypb.Empty, error) {
 	if req.Type == eventpb.StockReservationEvent_TYPE_STOCK_RESERVED {
+		// Filled backorders are shipped separately to the rest of the order
+		var backorderReservationId *string
+		if req.BackorderFill {
+			backorderReservationId = &req.ReservationId
+		}
+
 		err := svc.store.AllocateOrderShipment(
 			ctx,
 			req.OrderId,
 			EventOrderMetadata{
-				CustomerId: req.OrderMetadata.CustomerId,
-				ItemsPrice: req.OrderMetadata.ItemsPrice,
-				TotalPrice: req.OrderMetadata.TotalPrice,
+				CustomerId:       req.OrderMetadata.CustomerId,
+				ItemsPrice:       req.OrderMetadata.ItemsPrice,
+				TotalPrice:       req.OrderMetadata.TotalPrice,
+				PaymentMethod:    req.OrderMetadata.PaymentMethod,
+				PaymentReference: req.OrderMetadata.PaymentReference,
 			},
 			req.ReservationStock,
+			backorderReservationId,
 		)
 		if err != nil {
 			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
@@ -141,6 +175,30 @@ func (svc ShippingService) ProcessPaymentProcessedEvent(ctx context.Context, req
 			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
 		}
 
+	} else if req.Type == eventpb.PaymentProcessedEvent_TYPE_SUCCESS {
+		// The shipment can be dispatched once the payment is held
+		err := svc.store.SetOrderPaymentAuthorized(ctx, req.OrderId, true)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+		}
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (svc ShippingService) ProcessTransactionVoidedEvent(ctx context.Context, req *eventpb.TransactionVoidedEvent) (*emptypb.Empty, error) {
+	// Shipments cannot be dispatched once the payment hold has been released
+	err := svc.store.SetOrderPaymentAuthorized(ctx, req.OrderId, false)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+	}
+
+	// Release the shipment if the payment hold expired
+	if req.Reason == eventpb.TransactionVoidedEvent_REASON_EXPIRED {
+		err := svc.store.CancelOrderShipment(ctx, req.OrderId)
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update in response to event", err)
+		}
 	}
 
 	return &emptypb.Empty{}, nil
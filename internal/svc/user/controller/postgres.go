@@ -32,7 +32,7 @@ import (
 	"github.com/hexolan/stocklet/internal/svc/user"
 )
 
-const pgUserBaseQuery string = "SELECT id, first_name, last_name, email, created_at, updated_at FROM users"
+const pgUserBaseQuery string = "SELECT id, first_name, last_name, email, preferred_currency, created_at, updated_at FROM users"
 
 type postgresController struct {
 	cl          *pgxpool.Pool
@@ -66,7 +66,7 @@ func (c postgresController) getUser(ctx context.Context, tx *pgx.Tx, userId stri
 	return userObj, nil
 }
 
-func (c postgresController) RegisterUser(ctx context.Context, email string, password string, firstName string, lastName string) (*pb.User, error) {
+func (c postgresController) RegisterUser(ctx context.Context, email string, password string, firstName string, lastName string, preferredCurrency string) (*pb.User, error) {
 	// Establish connection with auth service
 	authConn, err := grpc.Dial(
 		c.serviceOpts.AuthServiceGrpc,
@@ -90,10 +90,11 @@ func (c postgresController) RegisterUser(ctx context.Context, email string, pass
 	var userId string
 	err = tx.QueryRow(
 		ctx,
-		"INSERT INTO users (first_name, last_name, email) VALUES ($1, $2, $3) RETURNING id",
+		"INSERT INTO users (first_name, last_name, email, preferred_currency) VALUES ($1, $2, $3, $4) RETURNING id",
 		firstName,
 		lastName,
 		email,
+		preferredCurrency,
 	).Scan(&userId)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to create user", err)
@@ -167,6 +168,48 @@ func (c postgresController) UpdateUserEmail(ctx context.Context, userId string,
 	return nil
 }
 
+func (c postgresController) UpdatePreferredCurrency(ctx context.Context, userId string, currency string) (*pb.User, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Update preferred currency
+	result, err := tx.Exec(ctx, "UPDATE users SET preferred_currency=$1, updated_at=timezone('utc', now()) WHERE id=$2", currency, userId)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to update preferred currency", err)
+	} else if result.RowsAffected() == 0 {
+		return nil, errors.NewServiceError(errors.ErrCodeNotFound, "user not found")
+	}
+
+	// Get the updated user
+	userObj, err := c.getUser(ctx, &tx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := user.PrepareUserPreferredCurrencyUpdatedEvent(userObj)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", userId, evtTopic, evt)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return userObj, nil
+}
+
 func (c postgresController) DeleteUser(ctx context.Context, userId string) (*pb.User, error) {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
@@ -220,6 +263,7 @@ func scanRowToUser(row pgx.Row) (*pb.User, error) {
 		&user.FirstName,
 		&user.LastName,
 		&user.Email,
+		&user.PreferredCurrency,
 		&tmpCreatedAt,
 		&tmpUpdatedAt,
 	)
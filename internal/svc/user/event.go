@@ -30,6 +30,8 @@ func PrepareUserCreatedEvent(user *pb.User) ([]byte, string, error) {
 		Email:     user.Email,
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
+
+		PreferredCurrency: user.PreferredCurrency,
 	}
 
 	return messaging.MarshalEvent(event, topic)
@@ -47,6 +49,18 @@ func PrepareUserEmailUpdatedEvent(userId string, email string) ([]byte, string,
 	return messaging.MarshalEvent(event, topic)
 }
 
+func PrepareUserPreferredCurrencyUpdatedEvent(user *pb.User) ([]byte, string, error) {
+	topic := messaging.User_Attribute_Currency_Topic
+	event := &eventspb.UserPreferredCurrencyUpdatedEvent{
+		Revision: 1,
+
+		UserId:            user.Id,
+		PreferredCurrency: user.PreferredCurrency,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
 func PrepareUserDeletedEvent(user *pb.User) ([]byte, string, error) {
 	topic := messaging.User_State_Deleted_Topic
 	event := &eventspb.UserDeletedEvent{
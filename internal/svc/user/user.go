@@ -22,7 +22,9 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	"github.com/hexolan/stocklet/internal/pkg/gwauth"
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	"github.com/hexolan/stocklet/internal/pkg/money"
 	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/user/v1"
 )
@@ -40,8 +42,9 @@ type UserService struct {
 type StorageController interface {
 	GetUser(ctx context.Context, userId string) (*pb.User, error)
 
-	RegisterUser(ctx context.Context, email string, password string, firstName string, lastName string) (*pb.User, error)
+	RegisterUser(ctx context.Context, email string, password string, firstName string, lastName string, preferredCurrency string) (*pb.User, error)
 	UpdateUserEmail(ctx context.Context, userId string, email string) error
+	UpdatePreferredCurrency(ctx context.Context, userId string, currency string) (*pb.User, error)
 
 	DeleteUser(ctx context.Context, userId string) (*pb.User, error)
 }
@@ -100,12 +103,48 @@ func (svc UserService) RegisterUser(ctx context.Context, req *pb.RegisterUserReq
 		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
 	}
 
+	// Use the default currency if a preference was not provided
+	preferredCurrency := money.DefaultCurrency
+	if req.PreferredCurrency != nil {
+		preferredCurrency = *req.PreferredCurrency
+	}
+
 	// Attempt to register the user
 	// This process involves calling the auth service to add an auth method for the user
-	user, err := svc.store.RegisterUser(ctx, req.Email, req.Password, req.FirstName, req.LastName)
+	user, err := svc.store.RegisterUser(ctx, req.Email, req.Password, req.FirstName, req.LastName, preferredCurrency)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.RegisterUserResponse{User: user}, nil
 }
+
+func (svc UserService) UpdatePreferredCurrency(ctx context.Context, req *pb.UpdatePreferredCurrencyRequest) (*pb.UpdatePreferredCurrencyResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// If the request is through the gateway, then ensure the user is updating themselves
+	gatewayRequest, gwMd := gwauth.IsGatewayRequest(ctx)
+	if gatewayRequest {
+		claims, err := gwauth.GetGatewayUser(gwMd)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.Id != claims.Subject {
+			return nil, errors.NewServiceError(errors.ErrCodeForbidden, "you do not have permission to update this user")
+		}
+	}
+
+	// Update the preference
+	// Dispatch UserPreferredCurrencyUpdatedEvent
+	user, err := svc.store.UpdatePreferredCurrency(ctx, req.Id, req.PreferredCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePreferredCurrencyResponse{User: user}, nil
+}
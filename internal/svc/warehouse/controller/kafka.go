@@ -53,9 +53,11 @@ func NewKafkaController(cl *kgo.Client) warehouse.ConsumerController {
 		messaging.Warehouse_Reservation_Returned_Topic,
 		messaging.Warehouse_Reservation_Consumed_Topic,
 
+		messaging.Product_Variant_Created_Topic,
 		messaging.Order_State_Pending_Topic,
 		messaging.Shipping_Shipment_Allocation_Topic,
 		messaging.Payment_Processing_Topic,
+		messaging.Order_Return_Approved_Topic,
 	)
 	if err != nil {
 		log.Warn().Err(err).Msg("kafka: raised attempting to ensure svc topics")
@@ -63,9 +65,11 @@ func NewKafkaController(cl *kgo.Client) warehouse.ConsumerController {
 
 	// Add the consumption topics
 	cl.AddConsumeTopics(
+		messaging.Product_Variant_Created_Topic,
 		messaging.Order_State_Pending_Topic,
 		messaging.Shipping_Shipment_Allocation_Topic,
 		messaging.Payment_Processing_Topic,
+		messaging.Order_Return_Approved_Topic,
 	)
 
 	return &kafkaController{cl: cl, ctx: ctx, ctxCancel: ctxCancel}
@@ -88,12 +92,16 @@ func (c *kafkaController) Start() {
 
 		fetches.EachTopic(func(ft kgo.FetchTopic) {
 			switch ft.Topic {
+			case messaging.Product_Variant_Created_Topic:
+				c.consumeProductVariantCreatedEventTopic(ft)
 			case messaging.Order_State_Pending_Topic:
 				c.consumeOrderPendingEventTopic(ft)
 			case messaging.Shipping_Shipment_Allocation_Topic:
 				c.consumeShipmentAllocationEventTopic(ft)
 			case messaging.Payment_Processing_Topic:
 				c.consumePaymentProcessedEventTopic(ft)
+			case messaging.Order_Return_Approved_Topic:
+				c.consumeReturnApprovedEventTopic(ft)
 			default:
 				log.Warn().Str("topic", ft.Topic).Msg("consumer: received records from unexpected topic")
 			}
@@ -106,6 +114,24 @@ func (c *kafkaController) Stop() {
 	c.ctxCancel()
 }
 
+func (c *kafkaController) consumeProductVariantCreatedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.ProductVariantCreatedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessProductVariantCreatedEvent(ctx, &event)
+	})
+}
+
 func (c *kafkaController) consumeOrderPendingEventTopic(ft kgo.FetchTopic) {
 	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
 
@@ -159,3 +185,21 @@ func (c *kafkaController) consumePaymentProcessedEventTopic(ft kgo.FetchTopic) {
 		c.svc.ProcessPaymentProcessedEvent(ctx, &event)
 	})
 }
+
+func (c *kafkaController) consumeReturnApprovedEventTopic(ft kgo.FetchTopic) {
+	log.Info().Str("topic", ft.Topic).Msg("consumer: received records from topic")
+
+	// Process each message from the topic
+	ft.EachRecord(func(record *kgo.Record) {
+		// Unmarshal the event
+		var event eventpb.ReturnApprovedEvent
+		err := proto.Unmarshal(record.Value, &event)
+		if err != nil {
+			log.Panic().Err(err).Msg("consumer: failed to unmarshal event")
+		}
+
+		// Process the event
+		ctx := context.Background()
+		c.svc.ProcessReturnApprovedEvent(ctx, &event)
+	})
+}
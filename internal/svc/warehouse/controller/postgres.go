@@ -23,14 +23,15 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/hexolan/stocklet/internal/pkg/errors"
+	eventpb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/warehouse/v1"
 	"github.com/hexolan/stocklet/internal/svc/warehouse"
 )
 
 const (
-	pgProductStockBaseQuery     string = "SELECT product_id, quantity FROM product_stock"
+	pgProductStockBaseQuery     string = "SELECT variant_id, quantity FROM product_stock"
 	pgReservationBaseQuery      string = "SELECT id, order_id, created_at FROM reservations"
-	pgReservationItemsBaseQuery string = "SELECT product_id, quantity FROM reservation_items"
+	pgReservationItemsBaseQuery string = "SELECT variant_id, quantity FROM reservation_items"
 )
 
 type postgresController struct {
@@ -41,18 +42,18 @@ func NewPostgresController(cl *pgxpool.Pool) warehouse.StorageController {
 	return postgresController{cl: cl}
 }
 
-func (c postgresController) GetProductStock(ctx context.Context, productId string) (*pb.ProductStock, error) {
-	return c.getProductStock(ctx, nil, productId)
+func (c postgresController) GetProductStock(ctx context.Context, variantId string) (*pb.ProductStock, error) {
+	return c.getProductStock(ctx, nil, variantId)
 }
 
-func (c postgresController) getProductStock(ctx context.Context, tx *pgx.Tx, productId string) (*pb.ProductStock, error) {
+func (c postgresController) getProductStock(ctx context.Context, tx *pgx.Tx, variantId string) (*pb.ProductStock, error) {
 	// Determine if a db transaction is being used
 	var row pgx.Row
-	const query = pgProductStockBaseQuery + " WHERE product_id=$1"
+	const query = pgProductStockBaseQuery + " WHERE variant_id=$1"
 	if tx == nil {
-		row = c.cl.QueryRow(ctx, query, productId)
+		row = c.cl.QueryRow(ctx, query, variantId)
 	} else {
-		row = (*tx).QueryRow(ctx, query, productId)
+		row = (*tx).QueryRow(ctx, query, variantId)
 	}
 
 	// Scan row to protobuf obj
@@ -96,7 +97,7 @@ func (c postgresController) getReservation(ctx context.Context, tx *pgx.Tx, rese
 func (c postgresController) getReservationByOrderId(ctx context.Context, tx *pgx.Tx, orderId string) (*pb.Reservation, error) {
 	// Determine if a db transaction is being used
 	var row pgx.Row
-	const query = pgReservationBaseQuery + " WHERE order_id=$1"
+	const query = pgReservationBaseQuery + " WHERE order_id=$1 AND backorder_fill=FALSE"
 	if tx == nil {
 		row = c.cl.QueryRow(ctx, query, orderId)
 	} else {
@@ -118,7 +119,7 @@ func (c postgresController) getReservationByOrderId(ctx context.Context, tx *pgx
 	return reservation, nil
 }
 
-func (c postgresController) CreateProductStock(ctx context.Context, productId string, startingQuantity int32) error {
+func (c postgresController) CreateProductStock(ctx context.Context, variantId string, startingQuantity int32) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -127,18 +128,18 @@ func (c postgresController) CreateProductStock(ctx context.Context, productId st
 	defer tx.Rollback(ctx)
 
 	// Create stock
-	_, err = tx.Exec(ctx, "INSERT INTO product_stock (product_id, quantity) VALUES ($1, $2)", productId, startingQuantity)
+	_, err = tx.Exec(ctx, "INSERT INTO product_stock (variant_id, quantity) VALUES ($1, $2)", variantId, startingQuantity)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to create product stock", err)
 	}
 
 	// Add the event to the outbox table with the transaction
-	evt, evtTopic, err := warehouse.PrepareStockCreatedEvent(&pb.ProductStock{ProductId: productId, Quantity: startingQuantity})
+	evt, evtTopic, err := warehouse.PrepareStockCreatedEvent(&pb.ProductStock{VariantId: variantId, Quantity: startingQuantity})
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
 
-	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", productId, evtTopic, evt)
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", variantId, evtTopic, evt)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
@@ -152,7 +153,177 @@ func (c postgresController) CreateProductStock(ctx context.Context, productId st
 	return nil
 }
 
-func (c postgresController) ReserveOrderStock(ctx context.Context, orderId string, orderMetadata warehouse.EventOrderMetadata, productQuantities map[string]int32) error {
+func (c postgresController) AddProductStock(ctx context.Context, variantId string, amount int32) (*pb.ProductStock, error) {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Add the stock
+	err = c.addProductStock(ctx, tx, variantId, amount, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the updated stock
+	stock, err := c.getProductStock(ctx, &tx, variantId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return stock, nil
+}
+
+// Restock the items of an approved order return.
+//
+// Items that have already been restocked for the return are skipped.
+func (c postgresController) RestockReturnedItems(ctx context.Context, returnId string, variantQuantities map[string]int32) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for variantId, quantity := range variantQuantities {
+		// Record the restock (ensuring the item has not already been restocked)
+		result, err := tx.Exec(
+			ctx,
+			"INSERT INTO restocks (return_id, variant_id, quantity) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+			returnId,
+			variantId,
+			quantity,
+		)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to record restock", err)
+		} else if result.RowsAffected() == 0 {
+			continue
+		}
+
+		// Add the stock
+		err = c.addProductStock(ctx, tx, variantId, quantity, &returnId)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Add stock for a product variant (as part of a transaction)
+//
+// Any backorders for the variant are filled from the added stock.
+func (c postgresController) addProductStock(ctx context.Context, tx pgx.Tx, variantId string, amount int32, returnId *string) error {
+	// Add to stock quantity
+	result, err := tx.Exec(ctx, "UPDATE product_stock SET quantity = quantity + $1 WHERE variant_id=$2", amount, variantId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product stock", err)
+	} else if result.RowsAffected() == 0 {
+		return errors.NewServiceError(errors.ErrCodeNotFound, "stock not found")
+	}
+
+	// Add the event to the outbox table with the transaction
+	evt, evtTopic, err := warehouse.PrepareStockAddedEvent(variantId, amount, nil, returnId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+	}
+
+	_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", variantId, evtTopic, evt)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+	}
+
+	// Fill any backorders with the new stock
+	return c.fillBackorders(ctx, tx, variantId)
+}
+
+// Fill backorders of a product variant (oldest first) with the available stock.
+//
+// Each filled backorder is reserved separately to the original order reservation.
+func (c postgresController) fillBackorders(ctx context.Context, tx pgx.Tx, variantId string) error {
+	// Get the outstanding backorders
+	rows, err := tx.Query(ctx, "SELECT order_id, customer_id, quantity FROM backorders WHERE variant_id=$1 ORDER BY created_at FOR UPDATE", variantId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to fetch backorders", err)
+	}
+	defer rows.Close()
+
+	type backorder struct {
+		orderId    string
+		customerId string
+		quantity   int32
+	}
+	backorders := []backorder{}
+	for rows.Next() {
+		var bo backorder
+		err := rows.Scan(&bo.orderId, &bo.customerId, &bo.quantity)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to scan backorder", err)
+		}
+
+		backorders = append(backorders, bo)
+	}
+
+	if rows.Err() != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "error whilst scanning backorder rows", rows.Err())
+	}
+
+	// Fill the backorders
+	for _, bo := range backorders {
+		// Create reservation
+		var reservationId string
+		err = tx.QueryRow(ctx, "INSERT INTO reservations (order_id, backorder_fill) VALUES ($1, TRUE) RETURNING id", bo.orderId).Scan(&reservationId)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to create reservation", err)
+		}
+
+		err = c.reserveStock(ctx, &tx, reservationId, variantId, bo.quantity)
+		if err != nil {
+			// There is insufficient stock to fill further backorders
+			_, err = tx.Exec(ctx, "DELETE FROM reservations WHERE id=$1", reservationId)
+			if err != nil {
+				return errors.WrapServiceError(errors.ErrCodeExtService, "failed to delete reservation", err)
+			}
+
+			break
+		}
+
+		// Remove the backorder
+		_, err = tx.Exec(ctx, "DELETE FROM backorders WHERE order_id=$1 AND variant_id=$2", bo.orderId, variantId)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to remove backorder", err)
+		}
+
+		// Add the event to the outbox table with the transaction
+		evt, evtTopic, err := warehouse.PrepareStockReservationEvent_BackorderFilled(bo.orderId, bo.customerId, reservationId, map[string]int32{variantId: bo.quantity})
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
+		}
+
+		_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", reservationId, evtTopic, evt)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
+		}
+	}
+
+	return nil
+}
+
+func (c postgresController) ReserveOrderStock(ctx context.Context, orderId string, orderMetadata warehouse.EventOrderMetadata, variantQuantities map[string]int32, reservationOpts warehouse.OrderReservationOpts) error {
 	// Begin a DB transaction
 	tx, err := c.cl.Begin(ctx)
 	if err != nil {
@@ -168,18 +339,22 @@ func (c postgresController) ReserveOrderStock(ctx context.Context, orderId strin
 	}
 
 	// Reserve the items
-	insufficientStockProductIds := []string{}
-	for productId, quantity := range productQuantities {
-		err = c.reserveStock(ctx, &tx, reservationId, productId, quantity)
+	reservedStock := make(map[string]int32)
+	insufficientStockVariantIds := []string{}
+	for variantId, quantity := range variantQuantities {
+		err = c.reserveStock(ctx, &tx, reservationId, variantId, quantity)
 		if err != nil {
-			insufficientStockProductIds = append(insufficientStockProductIds, productId)
+			insufficientStockVariantIds = append(insufficientStockVariantIds, variantId)
+		} else {
+			reservedStock[variantId] = quantity
 		}
 	}
 
 	// Ensure that all of the stock was reserved
-	if len(insufficientStockProductIds) > 0 {
+	// (unless the order allows for partial fulfilment and some stock was reserved)
+	if len(insufficientStockVariantIds) > 0 && (!reservationOpts.AllowsPartialReservation() || len(reservedStock) == 0) {
 		// Add the event to the outbox table with the transaction
-		evt, evtTopic, err := warehouse.PrepareStockReservationEvent_Failed(orderId, orderMetadata, insufficientStockProductIds)
+		evt, evtTopic, err := warehouse.PrepareStockReservationEvent_Failed(orderId, orderMetadata, insufficientStockVariantIds)
 		if err != nil {
 			return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 		}
@@ -192,8 +367,34 @@ func (c postgresController) ReserveOrderStock(ctx context.Context, orderId strin
 		return nil
 	}
 
+	// Handle items with insufficient stock
+	droppedVariantIds := []string{}
+	backorderedStock := make(map[string]int32)
+	if reservationOpts.FulfilmentMode == eventpb.OrderPendingEvent_FULFILMENT_MODE_BACKORDER {
+		// Backorder the items (to be filled once restocked)
+		for _, variantId := range insufficientStockVariantIds {
+			_, err = tx.Exec(
+				ctx,
+				"INSERT INTO backorders (order_id, variant_id, quantity, customer_id) VALUES ($1, $2, $3, $4)",
+				orderId,
+				variantId,
+				variantQuantities[variantId],
+				orderMetadata.CustomerId,
+			)
+			if err != nil {
+				return errors.WrapServiceError(errors.ErrCodeExtService, "failed to create backorder", err)
+			}
+
+			backorderedStock[variantId] = variantQuantities[variantId]
+		}
+	} else if len(insufficientStockVariantIds) > 0 {
+		// Drop the items from the order
+		droppedVariantIds = insufficientStockVariantIds
+		orderMetadata = reservationOpts.AdjustOrderMetadata(orderMetadata, droppedVariantIds)
+	}
+
 	// Add the event to the outbox table with the transaction
-	evt, evtTopic, err := warehouse.PrepareStockReservationEvent_Reserved(orderId, orderMetadata, reservationId, productQuantities)
+	evt, evtTopic, err := warehouse.PrepareStockReservationEvent_Reserved(orderId, orderMetadata, reservationId, reservedStock, droppedVariantIds, backorderedStock)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 	}
@@ -212,7 +413,7 @@ func (c postgresController) ReserveOrderStock(ctx context.Context, orderId strin
 	return nil
 }
 
-func (c postgresController) reserveStock(ctx context.Context, tx *pgx.Tx, reservationId string, productId string, quantity int32) error {
+func (c postgresController) reserveStock(ctx context.Context, tx *pgx.Tx, reservationId string, variantId string, quantity int32) error {
 	// Determine if a transaction has already been provided
 	var (
 		funcTx pgx.Tx
@@ -228,25 +429,16 @@ func (c postgresController) reserveStock(ctx context.Context, tx *pgx.Tx, reserv
 		defer funcTx.Rollback(ctx)
 	}
 
-	// Subtract from quantity
-	_, err = funcTx.Exec(ctx, "UPDATE product_stock SET quantity = quantity - $1 WHERE product_id=$2", quantity, productId)
+	// Subtract from quantity (ensuring that the stock will not become negative)
+	result, err := funcTx.Exec(ctx, "UPDATE product_stock SET quantity = quantity - $1 WHERE variant_id=$2 AND quantity >= $1", quantity, variantId)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product stock", err)
-	}
-
-	// Get updated stock
-	stock, err := c.getProductStock(ctx, tx, productId)
-	if err != nil {
-		return err
-	}
-
-	// Ensure that the stock is not negative
-	if stock.Quantity < 0 {
+	} else if result.RowsAffected() == 0 {
 		return errors.NewServiceError(errors.ErrCodeInvalidArgument, "insufficient stock")
 	}
 
 	// Add as reservation item
-	_, err = funcTx.Exec(ctx, "INSERT INTO reservation_items (reservation_id, product_id, quantity) VALUES ($1, $2, $3)", reservationId, productId, quantity)
+	_, err = funcTx.Exec(ctx, "INSERT INTO reservation_items (reservation_id, variant_id, quantity) VALUES ($1, $2, $3)", reservationId, variantId, quantity)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to add as reservation item", err)
 	}
@@ -276,9 +468,79 @@ func (c postgresController) ReturnReservedOrderStock(ctx context.Context, orderI
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to locate order reservation", err)
 	}
 
-	// Return all of the reserved stock
+	// Return the reservation
+	err = c.returnReservation(ctx, tx, reservation)
+	if err != nil {
+		return err
+	}
+
+	// Cancel any outstanding backorders for the order
+	_, err = tx.Exec(ctx, "DELETE FROM backorders WHERE order_id=$1", orderId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to cancel backorders", err)
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Return the stock reserved to fill a backorder.
+//
+// The items are backordered again (to be filled upon later restock).
+func (c postgresController) ReturnBackorderFillStock(ctx context.Context, reservationId string, customerId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Get the reservation
+	reservation, err := c.getReservation(ctx, &tx, reservationId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to locate reservation", err)
+	}
+
+	// Return the reservation
+	err = c.returnReservation(ctx, tx, reservation)
+	if err != nil {
+		return err
+	}
+
+	// Backorder the items again
+	for _, reservedStock := range reservation.ReservedStock {
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO backorders (order_id, variant_id, quantity, customer_id) VALUES ($1, $2, $3, $4) ON CONFLICT (order_id, variant_id) DO UPDATE SET quantity = backorders.quantity + EXCLUDED.quantity",
+			reservation.OrderId,
+			reservedStock.VariantId,
+			reservedStock.Quantity,
+			customerId,
+		)
+		if err != nil {
+			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to create backorder", err)
+		}
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Return all reserved stock and delete the reservation
+func (c postgresController) returnReservation(ctx context.Context, tx pgx.Tx, reservation *pb.Reservation) error {
+	var err error
 	for _, reservedStock := range reservation.ReservedStock {
-		err = c.returnReservedStock(ctx, &tx, reservation.Id, reservedStock.ProductId, reservedStock.Quantity)
+		err = c.returnReservedStock(ctx, &tx, reservation.Id, reservedStock.VariantId, reservedStock.Quantity)
 		if err != nil {
 			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to return reserved stock", err)
 		}
@@ -301,16 +563,10 @@ func (c postgresController) ReturnReservedOrderStock(ctx context.Context, orderI
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
 
-	// Commit the transaction
-	err = tx.Commit(ctx)
-	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
-	}
-
 	return nil
 }
 
-func (c postgresController) returnReservedStock(ctx context.Context, tx *pgx.Tx, reservationId string, productId string, quantity int32) error {
+func (c postgresController) returnReservedStock(ctx context.Context, tx *pgx.Tx, reservationId string, variantId string, quantity int32) error {
 	// Determine if a transaction has already been provided
 	var (
 		funcTx pgx.Tx
@@ -327,13 +583,13 @@ func (c postgresController) returnReservedStock(ctx context.Context, tx *pgx.Tx,
 	}
 
 	// Add back to stock quantity
-	_, err = funcTx.Exec(ctx, "UPDATE product_stock SET quantity = quantity + $1 WHERE product_id=$2", quantity, productId)
+	_, err = funcTx.Exec(ctx, "UPDATE product_stock SET quantity = quantity + $1 WHERE variant_id=$2", quantity, variantId)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to update product stock", err)
 	}
 
 	// Delete reservation item
-	_, err = funcTx.Exec(ctx, "DELETE FROM reservation_items WHERE reservation_id=$1 AND product_id=$2", reservationId, productId)
+	_, err = funcTx.Exec(ctx, "DELETE FROM reservation_items WHERE reservation_id=$1 AND variant_id=$2", reservationId, variantId)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to add as reservation item", err)
 	}
@@ -363,20 +619,66 @@ func (c postgresController) ConsumeReservedOrderStock(ctx context.Context, order
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to locate order reservation", err)
 	}
 
+	// Consume the reservation
+	err = c.consumeReservation(ctx, tx, reservation)
+	if err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+func (c postgresController) ConsumeBackorderFillStock(ctx context.Context, reservationId string) error {
+	// Begin a DB transaction
+	tx, err := c.cl.Begin(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to begin transaction", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Get the reservation
+	reservation, err := c.getReservation(ctx, &tx, reservationId)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to locate reservation", err)
+	}
+
+	// Consume the reservation
+	err = c.consumeReservation(ctx, tx, reservation)
+	if err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	err = tx.Commit(ctx)
+	if err != nil {
+		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
+	}
+
+	return nil
+}
+
+// Delete the reservation and dispatch events for the removal of the reserved stock
+func (c postgresController) consumeReservation(ctx context.Context, tx pgx.Tx, reservation *pb.Reservation) error {
 	// Delete the reservation
-	_, err = tx.Exec(ctx, "DELETE FROM reservations WHERE id=$1", reservation.Id)
+	_, err := tx.Exec(ctx, "DELETE FROM reservations WHERE id=$1", reservation.Id)
 	if err != nil {
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to delete reservation", err)
 	}
 
 	// Dispatch stock removed events
 	for _, reservedStock := range reservation.ReservedStock {
-		evt, evtTopic, err := warehouse.PrepareStockRemovedEvent(reservedStock.ProductId, reservedStock.Quantity, &reservation.Id)
+		evt, evtTopic, err := warehouse.PrepareStockRemovedEvent(reservedStock.VariantId, reservedStock.Quantity, &reservation.Id)
 		if err != nil {
 			return errors.WrapServiceError(errors.ErrCodeService, "failed to create event", err)
 		}
 
-		_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", reservedStock.ProductId, evtTopic, evt)
+		_, err = tx.Exec(ctx, "INSERT INTO event_outbox (aggregateid, aggregatetype, payload) VALUES ($1, $2, $3)", reservedStock.VariantId, evtTopic, evt)
 		if err != nil {
 			return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 		}
@@ -393,12 +695,6 @@ func (c postgresController) ConsumeReservedOrderStock(ctx context.Context, order
 		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to insert event", err)
 	}
 
-	// Commit the transaction
-	err = tx.Commit(ctx)
-	if err != nil {
-		return errors.WrapServiceError(errors.ErrCodeExtService, "failed to commit transaction", err)
-	}
-
 	return nil
 }
 
@@ -422,7 +718,7 @@ func (c postgresController) getReservationItems(ctx context.Context, tx *pgx.Tx,
 		var reservStock pb.ReservationStock
 
 		err := rows.Scan(
-			&reservStock.ProductId,
+			&reservStock.VariantId,
 			&reservStock.Quantity,
 		)
 		if err != nil {
@@ -457,7 +753,7 @@ func scanRowToProductStock(row pgx.Row) (*pb.ProductStock, error) {
 	var stock pb.ProductStock
 
 	err := row.Scan(
-		&stock.ProductId,
+		&stock.VariantId,
 		&stock.Quantity,
 	)
 	if err != nil {
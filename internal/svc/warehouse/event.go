@@ -17,47 +17,95 @@ package warehouse
 
 import (
 	"github.com/hexolan/stocklet/internal/pkg/messaging"
+	"github.com/hexolan/stocklet/internal/pkg/money"
+	commonpb "github.com/hexolan/stocklet/internal/pkg/protogen/common/v1"
 	eventspb "github.com/hexolan/stocklet/internal/pkg/protogen/events/v1"
 	pb "github.com/hexolan/stocklet/internal/pkg/protogen/warehouse/v1"
 )
 
 type EventOrderMetadata struct {
 	CustomerId string
-	ItemsPrice float32
-	TotalPrice float32
+	ItemsPrice *commonpb.Money
+	TotalPrice *commonpb.Money
+
+	PaymentMethod    commonpb.PaymentMethod
+	PaymentReference *string
+}
+
+// Options for reserving the stock of an order
+type OrderReservationOpts struct {
+	// Determines how items with insufficient stock are handled
+	FulfilmentMode eventspb.OrderPendingEvent_FulfilmentMode
+
+	// Variant ID: Line Total
+	ItemLineTotals map[string]*commonpb.Money
+	ShippingPrice  *commonpb.Money
+}
+
+// Determine if the order allows for items with insufficient stock
+func (opts OrderReservationOpts) AllowsPartialReservation() bool {
+	return opts.FulfilmentMode == eventspb.OrderPendingEvent_FULFILMENT_MODE_PARTIAL || opts.FulfilmentMode == eventspb.OrderPendingEvent_FULFILMENT_MODE_BACKORDER
+}
+
+// Adjust the order prices to exclude dropped items.
+//
+// Dropped items are deducted from the total price in proportion to their share of
+// the items price (so any discounts and taxes are adjusted accordingly).
+// The shipping price is unaffected.
+func (opts OrderReservationOpts) AdjustOrderMetadata(orderMetadata EventOrderMetadata, droppedVariantIds []string) EventOrderMetadata {
+	var droppedPrice int64
+	for _, variantId := range droppedVariantIds {
+		droppedPrice += opts.ItemLineTotals[variantId].GetMinorUnits()
+	}
+
+	itemsPrice := orderMetadata.ItemsPrice.GetMinorUnits()
+	if itemsPrice <= 0 || droppedPrice <= 0 {
+		return orderMetadata
+	}
+
+	totalPrice := orderMetadata.TotalPrice.GetMinorUnits()
+	adjustment := money.Prorate(totalPrice-opts.ShippingPrice.GetMinorUnits(), droppedPrice, itemsPrice)
+	return EventOrderMetadata{
+		CustomerId:       orderMetadata.CustomerId,
+		ItemsPrice:       money.New(itemsPrice-droppedPrice, orderMetadata.ItemsPrice.GetCurrencyCode()),
+		TotalPrice:       money.New(totalPrice-adjustment, orderMetadata.TotalPrice.GetCurrencyCode()),
+		PaymentMethod:    orderMetadata.PaymentMethod,
+		PaymentReference: orderMetadata.PaymentReference,
+	}
 }
 
 func PrepareStockCreatedEvent(productStock *pb.ProductStock) ([]byte, string, error) {
 	topic := messaging.Warehouse_Stock_Created_Topic
 	event := &eventspb.StockCreatedEvent{
-		Revision: 1,
+		Revision: 2,
 
-		ProductId: productStock.ProductId,
+		VariantId: productStock.VariantId,
 		Quantity:  productStock.Quantity,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareStockAddedEvent(productId string, amount int32, reservationId *string) ([]byte, string, error) {
+func PrepareStockAddedEvent(variantId string, amount int32, reservationId *string, returnId *string) ([]byte, string, error) {
 	topic := messaging.Warehouse_Stock_Added_Topic
 	event := &eventspb.StockAddedEvent{
-		Revision: 1,
+		Revision: 2,
 
-		ProductId:     productId,
+		VariantId:     variantId,
 		Amount:        amount,
 		ReservationId: reservationId,
+		ReturnId:      returnId,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareStockRemovedEvent(productId string, amount int32, reservationId *string) ([]byte, string, error) {
+func PrepareStockRemovedEvent(variantId string, amount int32, reservationId *string) ([]byte, string, error) {
 	topic := messaging.Warehouse_Stock_Removed_Topic
 	event := &eventspb.StockRemovedEvent{
-		Revision: 1,
+		Revision: 2,
 
-		ProductId:     productId,
+		VariantId:     variantId,
 		Amount:        amount,
 		ReservationId: reservationId,
 	}
@@ -65,38 +113,62 @@ func PrepareStockRemovedEvent(productId string, amount int32, reservationId *str
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareStockReservationEvent_Failed(orderId string, orderMetadata EventOrderMetadata, insufficientStockProductIds []string) ([]byte, string, error) {
+func PrepareStockReservationEvent_Failed(orderId string, orderMetadata EventOrderMetadata, insufficientStockVariantIds []string) ([]byte, string, error) {
 	topic := messaging.Warehouse_Reservation_Failed_Topic
 	event := &eventspb.StockReservationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:    eventspb.StockReservationEvent_TYPE_INSUFFICIENT_STOCK,
 		OrderId: orderId,
 		OrderMetadata: &eventspb.StockReservationEvent_OrderMetadata{
-			CustomerId: orderMetadata.CustomerId,
-			ItemsPrice: orderMetadata.ItemsPrice,
-			TotalPrice: orderMetadata.TotalPrice,
+			CustomerId:       orderMetadata.CustomerId,
+			ItemsPrice:       orderMetadata.ItemsPrice,
+			TotalPrice:       orderMetadata.TotalPrice,
+			PaymentMethod:    orderMetadata.PaymentMethod,
+			PaymentReference: orderMetadata.PaymentReference,
+		},
+		InsufficientStock: insufficientStockVariantIds,
+	}
+
+	return messaging.MarshalEvent(event, topic)
+}
+
+func PrepareStockReservationEvent_Reserved(orderId string, orderMetadata EventOrderMetadata, reservationId string, reservationStock map[string]int32, droppedVariantIds []string, backorderedStock map[string]int32) ([]byte, string, error) {
+	topic := messaging.Warehouse_Reservation_Reserved_Topic
+	event := &eventspb.StockReservationEvent{
+		Revision: 3,
+
+		Type:    eventspb.StockReservationEvent_TYPE_STOCK_RESERVED,
+		OrderId: orderId,
+		OrderMetadata: &eventspb.StockReservationEvent_OrderMetadata{
+			CustomerId:       orderMetadata.CustomerId,
+			ItemsPrice:       orderMetadata.ItemsPrice,
+			TotalPrice:       orderMetadata.TotalPrice,
+			PaymentMethod:    orderMetadata.PaymentMethod,
+			PaymentReference: orderMetadata.PaymentReference,
 		},
-		InsufficientStock: insufficientStockProductIds,
+		ReservationId:     reservationId,
+		ReservationStock:  reservationStock,
+		InsufficientStock: droppedVariantIds,
+		BackorderedStock:  backorderedStock,
 	}
 
 	return messaging.MarshalEvent(event, topic)
 }
 
-func PrepareStockReservationEvent_Reserved(orderId string, orderMetadata EventOrderMetadata, reservationId string, reservationStock map[string]int32) ([]byte, string, error) {
+func PrepareStockReservationEvent_BackorderFilled(orderId string, customerId string, reservationId string, reservationStock map[string]int32) ([]byte, string, error) {
 	topic := messaging.Warehouse_Reservation_Reserved_Topic
 	event := &eventspb.StockReservationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:    eventspb.StockReservationEvent_TYPE_STOCK_RESERVED,
 		OrderId: orderId,
 		OrderMetadata: &eventspb.StockReservationEvent_OrderMetadata{
-			CustomerId: orderMetadata.CustomerId,
-			ItemsPrice: orderMetadata.ItemsPrice,
-			TotalPrice: orderMetadata.TotalPrice,
+			CustomerId: customerId,
 		},
 		ReservationId:    reservationId,
 		ReservationStock: reservationStock,
+		BackorderFill:    true,
 	}
 
 	return messaging.MarshalEvent(event, topic)
@@ -105,12 +177,12 @@ func PrepareStockReservationEvent_Reserved(orderId string, orderMetadata EventOr
 func PrepareStockReservationEvent_Returned(orderId string, reservationId string, reservedStock []*pb.ReservationStock) ([]byte, string, error) {
 	reservationStock := make(map[string]int32)
 	for _, item := range reservedStock {
-		reservationStock[item.ProductId] = item.Quantity
+		reservationStock[item.VariantId] = item.Quantity
 	}
 
 	topic := messaging.Warehouse_Reservation_Returned_Topic
 	event := &eventspb.StockReservationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:             eventspb.StockReservationEvent_TYPE_STOCK_RESERVED,
 		OrderId:          orderId,
@@ -124,12 +196,12 @@ func PrepareStockReservationEvent_Returned(orderId string, reservationId string,
 func PrepareStockReservationEvent_Consumed(orderId string, reservationId string, reservedStock []*pb.ReservationStock) ([]byte, string, error) {
 	reservationStock := make(map[string]int32)
 	for _, item := range reservedStock {
-		reservationStock[item.ProductId] = item.Quantity
+		reservationStock[item.VariantId] = item.Quantity
 	}
 
 	topic := messaging.Warehouse_Reservation_Consumed_Topic
 	event := &eventspb.StockReservationEvent{
-		Revision: 1,
+		Revision: 3,
 
 		Type:             eventspb.StockReservationEvent_TYPE_STOCK_CONSUMED,
 		OrderId:          orderId,
@@ -40,14 +40,19 @@ type WarehouseService struct {
 // Interface for database methods
 // Flexibility for implementing separate controllers for different databases (e.g. Postgres, MongoDB, etc)
 type StorageController interface {
-	GetProductStock(ctx context.Context, productId string) (*pb.ProductStock, error)
+	GetProductStock(ctx context.Context, variantId string) (*pb.ProductStock, error)
 	GetReservation(ctx context.Context, reservationId string) (*pb.Reservation, error)
 
-	CreateProductStock(ctx context.Context, productId string, startingQuantity int32) error
+	CreateProductStock(ctx context.Context, variantId string, startingQuantity int32) error
+	AddProductStock(ctx context.Context, variantId string, amount int32) (*pb.ProductStock, error)
+	RestockReturnedItems(ctx context.Context, returnId string, variantQuantities map[string]int32) error
 
-	ReserveOrderStock(ctx context.Context, orderId string, orderMetadata EventOrderMetadata, productQuantities map[string]int32) error
+	ReserveOrderStock(ctx context.Context, orderId string, orderMetadata EventOrderMetadata, variantQuantities map[string]int32, reservationOpts OrderReservationOpts) error
 	ReturnReservedOrderStock(ctx context.Context, orderId string) error
 	ConsumeReservedOrderStock(ctx context.Context, orderId string) error
+
+	ReturnBackorderFillStock(ctx context.Context, reservationId string, customerId string) error
+	ConsumeBackorderFillStock(ctx context.Context, reservationId string) error
 }
 
 // Interface for event consumption
@@ -89,7 +94,7 @@ func (svc WarehouseService) ViewProductStock(ctx context.Context, req *pb.ViewPr
 	}
 
 	// Get stock from db
-	stock, err := svc.store.GetProductStock(ctx, req.ProductId)
+	stock, err := svc.store.GetProductStock(ctx, req.VariantId)
 	if err != nil {
 		return nil, err
 	}
@@ -113,8 +118,24 @@ func (svc WarehouseService) ViewReservation(ctx context.Context, req *pb.ViewRes
 	return &pb.ViewReservationResponse{Reservation: reservation}, nil
 }
 
-func (svc WarehouseService) ProcessProductCreatedEvent(ctx context.Context, req *eventpb.ProductCreatedEvent) (*emptypb.Empty, error) {
-	err := svc.store.CreateProductStock(ctx, req.ProductId, 0)
+func (svc WarehouseService) AddProductStock(ctx context.Context, req *pb.AddProductStockRequest) (*pb.AddProductStockResponse, error) {
+	// Validate the request args
+	if err := svc.pbVal.Validate(req); err != nil {
+		// Provide the validation error to the user.
+		return nil, errors.NewServiceError(errors.ErrCodeInvalidArgument, "invalid request: "+err.Error())
+	}
+
+	// Add the stock (filling any backorders)
+	stock, err := svc.store.AddProductStock(ctx, req.VariantId, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddProductStockResponse{Stock: stock}, nil
+}
+
+func (svc WarehouseService) ProcessProductVariantCreatedEvent(ctx context.Context, req *eventpb.ProductVariantCreatedEvent) (*emptypb.Empty, error) {
+	err := svc.store.CreateProductStock(ctx, req.VariantId, 0)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
 	}
@@ -127,11 +148,18 @@ func (svc WarehouseService) ProcessOrderPendingEvent(ctx context.Context, req *e
 		ctx,
 		req.OrderId,
 		EventOrderMetadata{
-			CustomerId: req.CustomerId,
-			ItemsPrice: req.ItemsPrice,
-			TotalPrice: req.TotalPrice,
+			CustomerId:       req.CustomerId,
+			ItemsPrice:       req.ItemsPrice,
+			TotalPrice:       req.TotalPrice,
+			PaymentMethod:    req.PaymentMethod,
+			PaymentReference: req.PaymentReference,
 		},
 		req.ItemQuantities,
+		OrderReservationOpts{
+			FulfilmentMode: req.FulfilmentMode,
+			ItemLineTotals: req.ItemLineTotals,
+			ShippingPrice:  req.ShippingPrice,
+		},
 	)
 	if err != nil {
 		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
@@ -141,6 +169,23 @@ func (svc WarehouseService) ProcessOrderPendingEvent(ctx context.Context, req *e
 }
 
 func (svc WarehouseService) ProcessShipmentAllocationEvent(ctx context.Context, req *eventpb.ShipmentAllocationEvent) (*emptypb.Empty, error) {
+	// Filled backorders have already been paid for
+	// so the stock is consumed once a shipment has been allocated
+	if req.BackorderReservationId != nil {
+		var err error
+		if req.Type == eventpb.ShipmentAllocationEvent_TYPE_ALLOCATED {
+			err = svc.store.ConsumeBackorderFillStock(ctx, *req.BackorderReservationId)
+		} else if req.Type == eventpb.ShipmentAllocationEvent_TYPE_FAILED {
+			err = svc.store.ReturnBackorderFillStock(ctx, *req.BackorderReservationId, req.OrderMetadata.GetCustomerId())
+		}
+
+		if err != nil {
+			return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+		}
+
+		return &emptypb.Empty{}, nil
+	}
+
 	if req.Type == eventpb.ShipmentAllocationEvent_TYPE_FAILED {
 		err := svc.store.ReturnReservedOrderStock(ctx, req.OrderId)
 		if err != nil {
@@ -166,3 +211,14 @@ func (svc WarehouseService) ProcessPaymentProcessedEvent(ctx context.Context, re
 
 	return &emptypb.Empty{}, nil
 }
+
+func (svc WarehouseService) ProcessReturnApprovedEvent(ctx context.Context, req *eventpb.ReturnApprovedEvent) (*emptypb.Empty, error) {
+	// Restock the returned items
+	// Dispatches a StockAddedEvent (referencing the return) for each item
+	err := svc.store.RestockReturnedItems(ctx, req.ReturnId, req.ItemQuantities)
+	if err != nil {
+		return nil, errors.WrapServiceError(errors.ErrCodeExtService, "error processing event", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,2 @@
+ALTER TABLE auth_methods
+    DROP COLUMN IF EXISTS role;
\ No newline at end of file
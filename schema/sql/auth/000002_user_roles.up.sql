@@ -0,0 +1,2 @@
+ALTER TABLE auth_methods
+    ADD COLUMN role varchar(32) CHECK (role IN ('customer', 'admin', 'service'));
\ No newline at end of file
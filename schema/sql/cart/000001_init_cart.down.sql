@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS cart_items CASCADE;
+DROP TABLE IF EXISTS cart_checkouts CASCADE;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE cart_items (
+    customer_id varchar(64),
+    product_id varchar(64),
+
+    quantity integer NOT NULL CHECK (quantity > 0),
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+    updated_at timestamp,
+
+    PRIMARY KEY (customer_id, product_id)
+);
+
+CREATE TABLE cart_checkouts (
+    order_id varchar(64) PRIMARY KEY,
+    customer_id varchar(64) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now())
+);
\ No newline at end of file
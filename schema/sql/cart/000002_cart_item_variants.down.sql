@@ -0,0 +1 @@
+ALTER TABLE cart_items RENAME COLUMN variant_id TO product_id;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE cart_items RENAME COLUMN product_id TO variant_id;
\ No newline at end of file
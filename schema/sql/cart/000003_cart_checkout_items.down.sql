@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cart_checkout_items CASCADE;
\ No newline at end of file
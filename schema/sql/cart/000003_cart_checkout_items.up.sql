@@ -0,0 +1,9 @@
+CREATE TABLE cart_checkout_items (
+    order_id varchar(64),
+    variant_id varchar(64),
+
+    quantity integer NOT NULL CHECK (quantity > 0),
+
+    PRIMARY KEY (order_id, variant_id),
+    FOREIGN KEY (order_id) REFERENCES cart_checkouts (order_id) ON DELETE CASCADE
+);
\ No newline at end of file
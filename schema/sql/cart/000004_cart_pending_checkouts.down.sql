@@ -0,0 +1 @@
+DROP TABLE IF EXISTS cart_pending_checkouts;
\ No newline at end of file
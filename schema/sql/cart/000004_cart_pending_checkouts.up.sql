@@ -0,0 +1,12 @@
+CREATE TABLE cart_pending_checkouts (
+    id bigserial PRIMARY KEY,
+    customer_id varchar(64) NOT NULL,
+
+    -- The key provided by the client (or empty if not provided)
+    idempotency_key varchar(128) NOT NULL DEFAULT '',
+    cart_hash varchar(64) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+
+    UNIQUE (customer_id, idempotency_key)
+);
\ No newline at end of file
@@ -0,0 +1,12 @@
+CREATE TABLE order_idempotency_keys (
+    customer_id varchar(64),
+    idempotency_key varchar(128),
+
+    order_id bigint NOT NULL,
+    cart_hash varchar(64) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+
+    PRIMARY KEY (customer_id, idempotency_key),
+    FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+);
\ No newline at end of file
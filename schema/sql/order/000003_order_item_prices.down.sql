@@ -0,0 +1,3 @@
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS unit_price,
+    DROP COLUMN IF EXISTS line_total;
\ No newline at end of file
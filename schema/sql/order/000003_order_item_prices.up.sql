@@ -0,0 +1,3 @@
+ALTER TABLE order_items
+    ADD COLUMN unit_price numeric(12, 2),
+    ADD COLUMN line_total numeric(12, 2);
\ No newline at end of file
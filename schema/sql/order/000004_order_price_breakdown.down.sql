@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS shipping_price,
+    DROP COLUMN IF EXISTS tax_price,
+    DROP COLUMN IF EXISTS discount_price,
+    ALTER COLUMN items_price TYPE money USING items_price::money,
+    ALTER COLUMN total_price TYPE money USING total_price::money;
\ No newline at end of file
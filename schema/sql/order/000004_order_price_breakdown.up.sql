@@ -0,0 +1,6 @@
+ALTER TABLE orders
+    ALTER COLUMN items_price TYPE numeric(12, 2) USING items_price::numeric,
+    ALTER COLUMN total_price TYPE numeric(12, 2) USING total_price::numeric,
+    ADD COLUMN shipping_price numeric(12, 2),
+    ADD COLUMN tax_price numeric(12, 2),
+    ADD COLUMN discount_price numeric(12, 2);
\ No newline at end of file
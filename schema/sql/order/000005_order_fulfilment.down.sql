@@ -0,0 +1,7 @@
+ALTER TABLE order_items
+    DROP COLUMN IF EXISTS dropped,
+    DROP COLUMN IF EXISTS backordered;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS fulfilment_mode,
+    DROP COLUMN IF EXISTS adjustment_price;
\ No newline at end of file
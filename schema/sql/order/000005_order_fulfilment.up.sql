@@ -0,0 +1,7 @@
+ALTER TABLE orders
+    ADD COLUMN fulfilment_mode smallint NOT NULL DEFAULT 1,
+    ADD COLUMN adjustment_price numeric(12, 2);
+
+ALTER TABLE order_items
+    ADD COLUMN dropped boolean NOT NULL DEFAULT FALSE,
+    ADD COLUMN backordered boolean NOT NULL DEFAULT FALSE;
\ No newline at end of file
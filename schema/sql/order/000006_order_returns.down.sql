@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS order_return_items CASCADE;
+DROP TABLE IF EXISTS order_returns CASCADE;
\ No newline at end of file
@@ -0,0 +1,31 @@
+CREATE TABLE order_returns (
+    id bigserial PRIMARY KEY,
+    order_id bigint NOT NULL,
+    customer_id varchar(64) NOT NULL,
+
+    status smallint NOT NULL,
+    reason varchar(512) NOT NULL DEFAULT '',
+
+    refund_amount numeric(12, 2) NOT NULL,
+    refund_id varchar(64),
+
+    restocked boolean NOT NULL DEFAULT FALSE,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+    updated_at timestamp,
+
+    FOREIGN KEY (order_id) REFERENCES orders (id) ON DELETE CASCADE
+);
+
+CREATE TABLE order_return_items (
+    return_id bigint,
+    product_id varchar(64),
+
+    quantity integer NOT NULL,
+    refund_amount numeric(12, 2) NOT NULL,
+
+    restocked boolean NOT NULL DEFAULT FALSE,
+
+    PRIMARY KEY (return_id, product_id),
+    FOREIGN KEY (return_id) REFERENCES order_returns (id) ON DELETE CASCADE
+);
\ No newline at end of file
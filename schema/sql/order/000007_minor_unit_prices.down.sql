@@ -0,0 +1,19 @@
+ALTER TABLE order_return_items
+    ALTER COLUMN refund_amount TYPE numeric(12, 2) USING refund_amount / 100.0;
+
+ALTER TABLE order_returns
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN refund_amount TYPE numeric(12, 2) USING refund_amount / 100.0;
+
+ALTER TABLE order_items
+    ALTER COLUMN unit_price TYPE numeric(12, 2) USING unit_price / 100.0,
+    ALTER COLUMN line_total TYPE numeric(12, 2) USING line_total / 100.0;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN items_price TYPE numeric(12, 2) USING items_price / 100.0,
+    ALTER COLUMN total_price TYPE numeric(12, 2) USING total_price / 100.0,
+    ALTER COLUMN shipping_price TYPE numeric(12, 2) USING shipping_price / 100.0,
+    ALTER COLUMN tax_price TYPE numeric(12, 2) USING tax_price / 100.0,
+    ALTER COLUMN discount_price TYPE numeric(12, 2) USING discount_price / 100.0,
+    ALTER COLUMN adjustment_price TYPE numeric(12, 2) USING adjustment_price / 100.0;
\ No newline at end of file
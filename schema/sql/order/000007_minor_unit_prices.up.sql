@@ -0,0 +1,21 @@
+ALTER TABLE orders
+    ALTER COLUMN items_price TYPE bigint USING round(items_price * 100)::bigint,
+    ALTER COLUMN total_price TYPE bigint USING round(total_price * 100)::bigint,
+    ALTER COLUMN shipping_price TYPE bigint USING round(shipping_price * 100)::bigint,
+    ALTER COLUMN tax_price TYPE bigint USING round(tax_price * 100)::bigint,
+    ALTER COLUMN discount_price TYPE bigint USING round(discount_price * 100)::bigint,
+    ALTER COLUMN adjustment_price TYPE bigint USING round(adjustment_price * 100)::bigint,
+    ADD COLUMN currency char(3);
+
+UPDATE orders SET currency = 'USD' WHERE items_price IS NOT NULL;
+
+ALTER TABLE order_items
+    ALTER COLUMN unit_price TYPE bigint USING round(unit_price * 100)::bigint,
+    ALTER COLUMN line_total TYPE bigint USING round(line_total * 100)::bigint;
+
+ALTER TABLE order_returns
+    ALTER COLUMN refund_amount TYPE bigint USING round(refund_amount * 100)::bigint,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE order_return_items
+    ALTER COLUMN refund_amount TYPE bigint USING round(refund_amount * 100)::bigint;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS exchange_rate;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE orders ADD COLUMN exchange_rate numeric(18, 8);
+
+UPDATE orders SET exchange_rate = 1 WHERE currency IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS payment_method,
+    DROP COLUMN IF EXISTS payment_token;
\ No newline at end of file
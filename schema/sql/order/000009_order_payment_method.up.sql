@@ -0,0 +1,3 @@
+ALTER TABLE orders
+    ADD COLUMN payment_method smallint NOT NULL DEFAULT 1,
+    ADD COLUMN payment_token varchar(128);
\ No newline at end of file
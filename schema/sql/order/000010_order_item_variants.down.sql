@@ -0,0 +1,3 @@
+ALTER TABLE order_items RENAME COLUMN variant_id TO product_id;
+
+ALTER TABLE order_return_items RENAME COLUMN variant_id TO product_id;
\ No newline at end of file
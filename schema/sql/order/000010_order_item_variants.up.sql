@@ -0,0 +1,3 @@
+ALTER TABLE order_items RENAME COLUMN product_id TO variant_id;
+
+ALTER TABLE order_return_items RENAME COLUMN product_id TO variant_id;
\ No newline at end of file
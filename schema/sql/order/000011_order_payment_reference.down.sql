@@ -0,0 +1,3 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS payment_reference,
+    ADD COLUMN payment_token varchar(128);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS payment_token,
+    ADD COLUMN payment_reference varchar(64);
\ No newline at end of file
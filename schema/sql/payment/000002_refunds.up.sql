@@ -0,0 +1,11 @@
+CREATE TABLE refunds (
+    id bigserial PRIMARY KEY,
+    return_id varchar(64) NOT NULL UNIQUE,
+
+    order_id varchar(64) NOT NULL,
+    customer_id varchar(64) NOT NULL,
+
+    amount money NOT NULL,
+
+    processed_at timestamp NOT NULL DEFAULT timezone('utc', now())
+);
\ No newline at end of file
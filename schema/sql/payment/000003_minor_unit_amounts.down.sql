@@ -0,0 +1,11 @@
+ALTER TABLE refunds
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN amount TYPE money USING (amount::numeric / 100)::money;
+
+ALTER TABLE customer_balances
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN balance TYPE money USING (balance::numeric / 100)::money;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN amount TYPE money USING (amount::numeric / 100)::money;
\ No newline at end of file
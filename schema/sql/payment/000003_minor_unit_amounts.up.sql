@@ -0,0 +1,11 @@
+ALTER TABLE transactions
+    ALTER COLUMN amount TYPE bigint USING round(amount::numeric * 100)::bigint,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE customer_balances
+    ALTER COLUMN balance TYPE bigint USING round(balance::numeric * 100)::bigint,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'USD';
+
+ALTER TABLE refunds
+    ALTER COLUMN amount TYPE bigint USING round(amount::numeric * 100)::bigint,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'USD';
\ No newline at end of file
@@ -0,0 +1,4 @@
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS charge_amount,
+    DROP COLUMN IF EXISTS charge_currency,
+    DROP COLUMN IF EXISTS exchange_rate;
\ No newline at end of file
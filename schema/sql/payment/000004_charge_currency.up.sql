@@ -0,0 +1,10 @@
+ALTER TABLE transactions
+    ADD COLUMN charge_amount bigint,
+    ADD COLUMN charge_currency char(3),
+    ADD COLUMN exchange_rate numeric(18, 8) NOT NULL DEFAULT 1;
+
+UPDATE transactions SET charge_amount = amount, charge_currency = currency;
+
+ALTER TABLE transactions
+    ALTER COLUMN charge_amount SET NOT NULL,
+    ALTER COLUMN charge_currency SET NOT NULL;
\ No newline at end of file
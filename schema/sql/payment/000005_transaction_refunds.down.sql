@@ -0,0 +1 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS refunded_amount;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE transactions ADD COLUMN refunded_amount bigint NOT NULL DEFAULT 0;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS payment_method,
+    DROP COLUMN IF EXISTS provider_reference;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE transactions
+    ADD COLUMN payment_method smallint NOT NULL DEFAULT 1,
+    ADD COLUMN provider_reference varchar(64);
\ No newline at end of file
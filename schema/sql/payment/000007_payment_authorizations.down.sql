@@ -0,0 +1,7 @@
+ALTER TABLE customer_balances
+    DROP COLUMN IF EXISTS held;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS status,
+    DROP COLUMN IF EXISTS expires_at,
+    DROP COLUMN IF EXISTS captured_at;
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE transactions
+    ADD COLUMN status smallint NOT NULL DEFAULT 2,
+    ADD COLUMN expires_at timestamp,
+    ADD COLUMN captured_at timestamp;
+
+UPDATE transactions SET captured_at = processed_at;
+
+ALTER TABLE customer_balances
+    ADD COLUMN held bigint NOT NULL DEFAULT 0;
\ No newline at end of file
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS transactions_customer_id_idx;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS type,
+    DROP COLUMN IF EXISTS balance_after,
+    DROP COLUMN IF EXISTS balance_currency;
\ No newline at end of file
@@ -0,0 +1,6 @@
+ALTER TABLE transactions
+    ADD COLUMN type smallint NOT NULL DEFAULT 1,
+    ADD COLUMN balance_after bigint,
+    ADD COLUMN balance_currency char(3);
+
+CREATE INDEX transactions_customer_id_idx ON transactions (customer_id, id DESC);
\ No newline at end of file
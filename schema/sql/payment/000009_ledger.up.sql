@@ -0,0 +1,52 @@
+CREATE TABLE ledger_accounts (
+    id bigserial PRIMARY KEY,
+    code varchar(128) NOT NULL UNIQUE,
+    currency char(3) NOT NULL,
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now())
+);
+
+CREATE TABLE ledger_entries (
+    id bigserial PRIMARY KEY,
+    transaction_id bigint REFERENCES transactions (id),
+
+    debit_account_id bigint NOT NULL REFERENCES ledger_accounts (id),
+    credit_account_id bigint NOT NULL REFERENCES ledger_accounts (id),
+
+    amount bigint NOT NULL CHECK (amount > 0),
+    currency char(3) NOT NULL,
+    description varchar(128) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+
+    CHECK (debit_account_id <> credit_account_id)
+);
+
+CREATE INDEX ledger_entries_debit_account_id_idx ON ledger_entries (debit_account_id);
+CREATE INDEX ledger_entries_credit_account_id_idx ON ledger_entries (credit_account_id);
+
+CREATE VIEW ledger_account_balances AS
+    SELECT a.id, a.code, a.currency, COALESCE(SUM(CASE WHEN e.credit_account_id = a.id THEN e.amount ELSE -e.amount END), 0)::bigint AS balance
+    FROM ledger_accounts a
+    LEFT JOIN ledger_entries e ON e.credit_account_id = a.id OR e.debit_account_id = a.id
+    GROUP BY a.id;
+
+-- Open the ledger with the existing balances
+INSERT INTO ledger_accounts (code, currency)
+    SELECT 'customer:' || customer_id || ':balance', currency FROM customer_balances
+    UNION ALL
+    SELECT 'customer:' || customer_id || ':held', currency FROM customer_balances
+    UNION ALL
+    SELECT DISTINCT 'system:opening:' || currency, currency FROM customer_balances;
+
+INSERT INTO ledger_entries (debit_account_id, credit_account_id, amount, currency, description)
+    SELECT o.id, a.id, b.balance, b.currency, 'opening balance'
+    FROM customer_balances b
+    JOIN ledger_accounts a ON a.code = 'customer:' || b.customer_id || ':balance'
+    JOIN ledger_accounts o ON o.code = 'system:opening:' || b.currency
+    WHERE b.balance > 0
+    UNION ALL
+    SELECT o.id, a.id, b.held, b.currency, 'opening balance (held funds)'
+    FROM customer_balances b
+    JOIN ledger_accounts a ON a.code = 'customer:' || b.customer_id || ':held'
+    JOIN ledger_accounts o ON o.code = 'system:opening:' || b.currency
+    WHERE b.held > 0;
\ No newline at end of file
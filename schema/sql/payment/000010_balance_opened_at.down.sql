@@ -0,0 +1,2 @@
+ALTER TABLE customer_balances
+    DROP COLUMN IF EXISTS opened_at;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE customer_balances
+    ADD COLUMN opened_at timestamp;
+
+ALTER TABLE customer_balances
+    ALTER COLUMN opened_at SET DEFAULT timezone('utc', now());
\ No newline at end of file
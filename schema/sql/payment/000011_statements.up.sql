@@ -0,0 +1,19 @@
+CREATE TABLE statements (
+    id bigserial PRIMARY KEY,
+    customer_id varchar(64) NOT NULL,
+
+    period_start timestamp NOT NULL,
+    period_end timestamp NOT NULL,
+
+    opening_balance bigint NOT NULL,
+    closing_balance bigint NOT NULL,
+    currency char(3) NOT NULL,
+    transaction_count int NOT NULL,
+
+    pdf bytea NOT NULL,
+    csv bytea NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+
+    UNIQUE (customer_id, period_start)
+);
\ No newline at end of file
@@ -0,0 +1,16 @@
+CREATE TABLE gift_cards (
+    id bigserial PRIMARY KEY,
+    code varchar(32) NOT NULL UNIQUE,
+
+    initial_value bigint NOT NULL CHECK (initial_value > 0),
+    remaining_value bigint NOT NULL CHECK (remaining_value >= 0),
+    currency char(3) NOT NULL,
+    status smallint NOT NULL,
+
+    customer_id varchar(64),
+
+    issued_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX gift_cards_customer_id_idx ON gift_cards (customer_id);
\ No newline at end of file
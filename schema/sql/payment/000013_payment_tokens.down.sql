@@ -0,0 +1 @@
+DROP TABLE IF EXISTS payment_tokens;
\ No newline at end of file
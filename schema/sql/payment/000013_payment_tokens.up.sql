@@ -0,0 +1,12 @@
+CREATE TABLE payment_tokens (
+    reference varchar(64) PRIMARY KEY,
+    order_id varchar(64) NOT NULL,
+    customer_id varchar(64) NOT NULL,
+    payment_method smallint NOT NULL,
+    token varchar(128) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+    expires_at timestamp NOT NULL
+);
+
+CREATE INDEX payment_tokens_order_id_idx ON payment_tokens (order_id);
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE products
+    DROP COLUMN IF EXISTS currency,
+    ALTER COLUMN price TYPE money USING (price::numeric / 100)::money;
\ No newline at end of file
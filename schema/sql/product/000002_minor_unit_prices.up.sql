@@ -0,0 +1,3 @@
+ALTER TABLE products
+    ALTER COLUMN price TYPE bigint USING round(price::numeric * 100)::bigint,
+    ADD COLUMN currency char(3) NOT NULL DEFAULT 'USD';
\ No newline at end of file
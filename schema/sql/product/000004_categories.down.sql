@@ -0,0 +1,5 @@
+ALTER TABLE products
+    DROP COLUMN IF EXISTS category_id,
+    DROP COLUMN IF EXISTS tags;
+
+DROP TABLE IF EXISTS categories;
\ No newline at end of file
@@ -0,0 +1,18 @@
+CREATE TABLE categories (
+    id bigserial PRIMARY KEY,
+    parent_id bigint REFERENCES categories (id),
+
+    name varchar(64) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+    updated_at timestamp,
+
+    UNIQUE NULLS NOT DISTINCT (parent_id, name)
+);
+
+ALTER TABLE products
+    ADD COLUMN category_id bigint REFERENCES categories (id),
+    ADD COLUMN tags varchar(32)[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX products_category_id_idx ON products (category_id);
+CREATE INDEX products_tags_idx ON products USING GIN (tags);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS product_variants;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE product_variants (
+    id bigserial PRIMARY KEY,
+    product_id bigint NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+
+    sku varchar(64) NOT NULL UNIQUE,
+    price bigint,
+    attributes jsonb NOT NULL DEFAULT '{}',
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+    updated_at timestamp
+);
+
+CREATE INDEX product_variants_product_id_idx ON product_variants (product_id);
+
+INSERT INTO product_variants (id, product_id, sku) SELECT id, id, 'PRD-' || id FROM products;
+
+SELECT setval(pg_get_serial_sequence('product_variants', 'id'), GREATEST((SELECT MAX(id) FROM product_variants), 1));
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE shipments DROP COLUMN IF EXISTS payment_authorized;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE shipments ADD COLUMN payment_authorized boolean DEFAULT FALSE;
+
+-- The payment status of existing (undispatched) shipments is unknown.
+-- It is checked with the payment service when they are dispatched.
+UPDATE shipments SET payment_authorized = NULL WHERE dispatched = FALSE;
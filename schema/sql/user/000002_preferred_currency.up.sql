@@ -0,0 +1,2 @@
+ALTER TABLE users
+    ADD COLUMN preferred_currency char(3) NOT NULL DEFAULT 'USD';
\ No newline at end of file
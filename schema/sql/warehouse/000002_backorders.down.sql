@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS backorders CASCADE;
+
+ALTER TABLE reservations
+    DROP COLUMN IF EXISTS backorder_fill;
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE reservations
+    ADD COLUMN backorder_fill boolean NOT NULL DEFAULT FALSE;
+
+CREATE TABLE backorders (
+    order_id varchar(64),
+    product_id varchar(64),
+
+    quantity integer NOT NULL,
+    customer_id varchar(64) NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+
+    PRIMARY KEY (order_id, product_id)
+);
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS restocks CASCADE;
\ No newline at end of file
@@ -0,0 +1,10 @@
+CREATE TABLE restocks (
+    return_id varchar(64),
+    product_id varchar(64),
+
+    quantity integer NOT NULL,
+
+    created_at timestamp NOT NULL DEFAULT timezone('utc', now()),
+
+    PRIMARY KEY (return_id, product_id)
+);
\ No newline at end of file
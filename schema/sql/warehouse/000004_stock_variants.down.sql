@@ -0,0 +1,7 @@
+ALTER TABLE product_stock RENAME COLUMN variant_id TO product_id;
+
+ALTER TABLE reservation_items RENAME COLUMN variant_id TO product_id;
+
+ALTER TABLE backorders RENAME COLUMN variant_id TO product_id;
+
+ALTER TABLE restocks RENAME COLUMN variant_id TO product_id;
\ No newline at end of file
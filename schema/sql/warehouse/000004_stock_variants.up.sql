@@ -0,0 +1,7 @@
+ALTER TABLE product_stock RENAME COLUMN product_id TO variant_id;
+
+ALTER TABLE reservation_items RENAME COLUMN product_id TO variant_id;
+
+ALTER TABLE backorders RENAME COLUMN product_id TO variant_id;
+
+ALTER TABLE restocks RENAME COLUMN product_id TO variant_id;
\ No newline at end of file